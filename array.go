@@ -0,0 +1,512 @@
+package qm
+
+import (
+	"database/sql/driver"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// arrayEscaper escapes the backslashes and double quotes of array elements.
+var arrayEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// pgArray encodes the elements of the slice vs as a PostgreSQL array
+// literal, such as {1,2,3} or {"a","b"}.
+func pgArray(vs interface{}) string {
+	rv := reflect.ValueOf(vs)
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := 0; i < rv.Len(); i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		switch e := rv.Index(i); e.Kind() {
+		case reflect.String:
+			sb.WriteByte('"')
+			sb.WriteString(arrayEscaper.Replace(e.String()))
+			sb.WriteByte('"')
+		case reflect.Float32, reflect.Float64:
+			switch f := e.Float(); {
+			case math.IsNaN(f):
+				sb.WriteString("NaN")
+			case math.IsInf(f, 1):
+				sb.WriteString("Infinity")
+			case math.IsInf(f, -1):
+				sb.WriteString("-Infinity")
+			default:
+				sb.WriteString(strconv.FormatFloat(f, 'g', -1, e.Type().Bits()))
+			}
+		default:
+			sb.WriteString(strconv.FormatInt(e.Int(), 10))
+		}
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// arrayArg binds the slice vs as an array literal cast to the array type
+// cast, a nil slice being bound as an empty array.
+func arrayArg(vs interface{}, cast string) interface{} {
+	return castArg{value: pgArray(vs), cast: cast}
+}
+
+// nullArrayArg is like arrayArg but binds a nil slice as NULL, so that
+// nullable fields can compare against NULL.
+func nullArrayArg(vs interface{}, cast string) interface{} {
+	if isNilValue(vs) {
+		return nil
+	}
+	return arrayArg(vs, cast)
+}
+
+// condHasElement returns a condition checking that the array col holds v.
+func condHasElement(col string, v interface{}) Condition {
+	return Condition{build: func(b *builder) {
+		b.writeArg(v)
+		b.writeString(" = ANY (")
+		b.writeColumn(col)
+		b.writeString(")")
+	}}
+}
+
+// arrayLength returns the number of elements of the array col.
+func arrayLength(col string) NumericExpr {
+	return NumericExpr{build: func(b *builder) {
+		b.writeString("cardinality(")
+		b.writeColumn(col)
+		b.writeString(")")
+	}}
+}
+
+// IntArrayField is a component that returns a WhereClause that contains a
+// comparison based on its bigint[] field and a slice of int values.
+type IntArrayField string
+
+type NullIntArrayField string
+
+func (f IntArrayField) ToValue(vs []int) Condition {
+	return CondWithoutAlias(string(f), OpEquals, arrayArg(vs, "bigint[]"))
+}
+
+func (f NullIntArrayField) ToNullValue(vs []int) Condition {
+	return CondWithoutAlias(string(f), OpEquals, nullArrayArg(vs, "bigint[]"))
+}
+
+func (f IntArrayField) Equals(vs []int) Condition {
+	return Cond(string(f), OpEquals, arrayArg(vs, "bigint[]"))
+}
+func (f NullIntArrayField) Equals(vs []int) Condition {
+	return CondNullable(string(f), OpEquals, nullArrayArg(vs, "bigint[]"))
+}
+
+func (f IntArrayField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullIntArrayField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullIntArrayField) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f IntArrayField) NotEquals(vs []int) Condition {
+	return Cond(string(f), OpNotEquals, arrayArg(vs, "bigint[]"))
+}
+func (f NullIntArrayField) NotEquals(vs []int) Condition {
+	return CondNullable(string(f), OpNotEquals, nullArrayArg(vs, "bigint[]"))
+}
+
+func (f IntArrayField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullIntArrayField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f IntArrayField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullIntArrayField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f IntArrayField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullIntArrayField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+func (f IntArrayField) Contains(vs ...int) Condition {
+	return Cond(string(f), OpContains, arrayArg(append([]int{}, vs...), "bigint[]"))
+}
+func (f NullIntArrayField) Contains(vs ...int) Condition {
+	return Cond(string(f), OpContains, arrayArg(append([]int{}, vs...), "bigint[]"))
+}
+
+func (f IntArrayField) ContainedBy(vs ...int) Condition {
+	return Cond(string(f), OpContainedBy, arrayArg(append([]int{}, vs...), "bigint[]"))
+}
+func (f NullIntArrayField) ContainedBy(vs ...int) Condition {
+	return Cond(string(f), OpContainedBy, arrayArg(append([]int{}, vs...), "bigint[]"))
+}
+
+func (f IntArrayField) Overlaps(vs ...int) Condition {
+	return Cond(string(f), OpOverlaps, arrayArg(append([]int{}, vs...), "bigint[]"))
+}
+func (f NullIntArrayField) Overlaps(vs ...int) Condition {
+	return Cond(string(f), OpOverlaps, arrayArg(append([]int{}, vs...), "bigint[]"))
+}
+
+func (f IntArrayField) HasElement(v int) Condition {
+	return condHasElement(string(f), v)
+}
+func (f NullIntArrayField) HasElement(v int) Condition {
+	return condHasElement(string(f), v)
+}
+
+func (f IntArrayField) Length() NumericExpr {
+	return arrayLength(string(f))
+}
+func (f NullIntArrayField) Length() NumericExpr {
+	return arrayLength(string(f))
+}
+
+func (f IntArrayField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullIntArrayField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f IntArrayField) WithAlias(alias string) IntArrayField {
+	return IntArrayField(parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullIntArrayField) WithAlias(alias string) NullIntArrayField {
+	return NullIntArrayField(parseColumn(string(f)).WithTable(alias).String())
+}
+
+// Int64ArrayField is a component that returns a WhereClause that contains a
+// comparison based on its bigint[] field and a slice of int64 values.
+type Int64ArrayField string
+
+type NullInt64ArrayField string
+
+func (f Int64ArrayField) ToValue(vs []int64) Condition {
+	return CondWithoutAlias(string(f), OpEquals, arrayArg(vs, "bigint[]"))
+}
+
+func (f NullInt64ArrayField) ToNullValue(vs []int64) Condition {
+	return CondWithoutAlias(string(f), OpEquals, nullArrayArg(vs, "bigint[]"))
+}
+
+func (f Int64ArrayField) Equals(vs []int64) Condition {
+	return Cond(string(f), OpEquals, arrayArg(vs, "bigint[]"))
+}
+func (f NullInt64ArrayField) Equals(vs []int64) Condition {
+	return CondNullable(string(f), OpEquals, nullArrayArg(vs, "bigint[]"))
+}
+
+func (f Int64ArrayField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullInt64ArrayField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullInt64ArrayField) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f Int64ArrayField) NotEquals(vs []int64) Condition {
+	return Cond(string(f), OpNotEquals, arrayArg(vs, "bigint[]"))
+}
+func (f NullInt64ArrayField) NotEquals(vs []int64) Condition {
+	return CondNullable(string(f), OpNotEquals, nullArrayArg(vs, "bigint[]"))
+}
+
+func (f Int64ArrayField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullInt64ArrayField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f Int64ArrayField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullInt64ArrayField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f Int64ArrayField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullInt64ArrayField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+func (f Int64ArrayField) Contains(vs ...int64) Condition {
+	return Cond(string(f), OpContains, arrayArg(append([]int64{}, vs...), "bigint[]"))
+}
+func (f NullInt64ArrayField) Contains(vs ...int64) Condition {
+	return Cond(string(f), OpContains, arrayArg(append([]int64{}, vs...), "bigint[]"))
+}
+
+func (f Int64ArrayField) ContainedBy(vs ...int64) Condition {
+	return Cond(string(f), OpContainedBy, arrayArg(append([]int64{}, vs...), "bigint[]"))
+}
+func (f NullInt64ArrayField) ContainedBy(vs ...int64) Condition {
+	return Cond(string(f), OpContainedBy, arrayArg(append([]int64{}, vs...), "bigint[]"))
+}
+
+func (f Int64ArrayField) Overlaps(vs ...int64) Condition {
+	return Cond(string(f), OpOverlaps, arrayArg(append([]int64{}, vs...), "bigint[]"))
+}
+func (f NullInt64ArrayField) Overlaps(vs ...int64) Condition {
+	return Cond(string(f), OpOverlaps, arrayArg(append([]int64{}, vs...), "bigint[]"))
+}
+
+func (f Int64ArrayField) HasElement(v int64) Condition {
+	return condHasElement(string(f), v)
+}
+func (f NullInt64ArrayField) HasElement(v int64) Condition {
+	return condHasElement(string(f), v)
+}
+
+func (f Int64ArrayField) Length() NumericExpr {
+	return arrayLength(string(f))
+}
+func (f NullInt64ArrayField) Length() NumericExpr {
+	return arrayLength(string(f))
+}
+
+func (f Int64ArrayField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullInt64ArrayField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f Int64ArrayField) WithAlias(alias string) Int64ArrayField {
+	return Int64ArrayField(parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullInt64ArrayField) WithAlias(alias string) NullInt64ArrayField {
+	return NullInt64ArrayField(parseColumn(string(f)).WithTable(alias).String())
+}
+
+// Float64ArrayField is a component that returns a WhereClause that contains a
+// comparison based on its double precision[] field and a slice of float64 values.
+type Float64ArrayField string
+
+type NullFloat64ArrayField string
+
+func (f Float64ArrayField) ToValue(vs []float64) Condition {
+	return CondWithoutAlias(string(f), OpEquals, arrayArg(vs, "double precision[]"))
+}
+
+func (f NullFloat64ArrayField) ToNullValue(vs []float64) Condition {
+	return CondWithoutAlias(string(f), OpEquals, nullArrayArg(vs, "double precision[]"))
+}
+
+func (f Float64ArrayField) Equals(vs []float64) Condition {
+	return Cond(string(f), OpEquals, arrayArg(vs, "double precision[]"))
+}
+func (f NullFloat64ArrayField) Equals(vs []float64) Condition {
+	return CondNullable(string(f), OpEquals, nullArrayArg(vs, "double precision[]"))
+}
+
+func (f Float64ArrayField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullFloat64ArrayField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullFloat64ArrayField) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f Float64ArrayField) NotEquals(vs []float64) Condition {
+	return Cond(string(f), OpNotEquals, arrayArg(vs, "double precision[]"))
+}
+func (f NullFloat64ArrayField) NotEquals(vs []float64) Condition {
+	return CondNullable(string(f), OpNotEquals, nullArrayArg(vs, "double precision[]"))
+}
+
+func (f Float64ArrayField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullFloat64ArrayField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f Float64ArrayField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullFloat64ArrayField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f Float64ArrayField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullFloat64ArrayField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+func (f Float64ArrayField) Contains(vs ...float64) Condition {
+	return Cond(string(f), OpContains, arrayArg(append([]float64{}, vs...), "double precision[]"))
+}
+func (f NullFloat64ArrayField) Contains(vs ...float64) Condition {
+	return Cond(string(f), OpContains, arrayArg(append([]float64{}, vs...), "double precision[]"))
+}
+
+func (f Float64ArrayField) ContainedBy(vs ...float64) Condition {
+	return Cond(string(f), OpContainedBy, arrayArg(append([]float64{}, vs...), "double precision[]"))
+}
+func (f NullFloat64ArrayField) ContainedBy(vs ...float64) Condition {
+	return Cond(string(f), OpContainedBy, arrayArg(append([]float64{}, vs...), "double precision[]"))
+}
+
+func (f Float64ArrayField) Overlaps(vs ...float64) Condition {
+	return Cond(string(f), OpOverlaps, arrayArg(append([]float64{}, vs...), "double precision[]"))
+}
+func (f NullFloat64ArrayField) Overlaps(vs ...float64) Condition {
+	return Cond(string(f), OpOverlaps, arrayArg(append([]float64{}, vs...), "double precision[]"))
+}
+
+func (f Float64ArrayField) HasElement(v float64) Condition {
+	return condHasElement(string(f), v)
+}
+func (f NullFloat64ArrayField) HasElement(v float64) Condition {
+	return condHasElement(string(f), v)
+}
+
+func (f Float64ArrayField) Length() NumericExpr {
+	return arrayLength(string(f))
+}
+func (f NullFloat64ArrayField) Length() NumericExpr {
+	return arrayLength(string(f))
+}
+
+func (f Float64ArrayField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullFloat64ArrayField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f Float64ArrayField) WithAlias(alias string) Float64ArrayField {
+	return Float64ArrayField(parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullFloat64ArrayField) WithAlias(alias string) NullFloat64ArrayField {
+	return NullFloat64ArrayField(parseColumn(string(f)).WithTable(alias).String())
+}
+
+// StringArrayField is a component that returns a WhereClause that contains a
+// comparison based on its text[] field and a slice of string values.
+type StringArrayField string
+
+type NullStringArrayField string
+
+func (f StringArrayField) ToValue(vs []string) Condition {
+	return CondWithoutAlias(string(f), OpEquals, arrayArg(vs, "text[]"))
+}
+
+func (f NullStringArrayField) ToNullValue(vs []string) Condition {
+	return CondWithoutAlias(string(f), OpEquals, nullArrayArg(vs, "text[]"))
+}
+
+func (f StringArrayField) Equals(vs []string) Condition {
+	return Cond(string(f), OpEquals, arrayArg(vs, "text[]"))
+}
+func (f NullStringArrayField) Equals(vs []string) Condition {
+	return CondNullable(string(f), OpEquals, nullArrayArg(vs, "text[]"))
+}
+
+func (f StringArrayField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullStringArrayField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullStringArrayField) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f StringArrayField) NotEquals(vs []string) Condition {
+	return Cond(string(f), OpNotEquals, arrayArg(vs, "text[]"))
+}
+func (f NullStringArrayField) NotEquals(vs []string) Condition {
+	return CondNullable(string(f), OpNotEquals, nullArrayArg(vs, "text[]"))
+}
+
+func (f StringArrayField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullStringArrayField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f StringArrayField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullStringArrayField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f StringArrayField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullStringArrayField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+func (f StringArrayField) Contains(vs ...string) Condition {
+	return Cond(string(f), OpContains, arrayArg(append([]string{}, vs...), "text[]"))
+}
+func (f NullStringArrayField) Contains(vs ...string) Condition {
+	return Cond(string(f), OpContains, arrayArg(append([]string{}, vs...), "text[]"))
+}
+
+func (f StringArrayField) ContainedBy(vs ...string) Condition {
+	return Cond(string(f), OpContainedBy, arrayArg(append([]string{}, vs...), "text[]"))
+}
+func (f NullStringArrayField) ContainedBy(vs ...string) Condition {
+	return Cond(string(f), OpContainedBy, arrayArg(append([]string{}, vs...), "text[]"))
+}
+
+func (f StringArrayField) Overlaps(vs ...string) Condition {
+	return Cond(string(f), OpOverlaps, arrayArg(append([]string{}, vs...), "text[]"))
+}
+func (f NullStringArrayField) Overlaps(vs ...string) Condition {
+	return Cond(string(f), OpOverlaps, arrayArg(append([]string{}, vs...), "text[]"))
+}
+
+func (f StringArrayField) HasElement(v string) Condition {
+	return condHasElement(string(f), v)
+}
+func (f NullStringArrayField) HasElement(v string) Condition {
+	return condHasElement(string(f), v)
+}
+
+func (f StringArrayField) Length() NumericExpr {
+	return arrayLength(string(f))
+}
+func (f NullStringArrayField) Length() NumericExpr {
+	return arrayLength(string(f))
+}
+
+func (f StringArrayField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullStringArrayField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f StringArrayField) WithAlias(alias string) StringArrayField {
+	return StringArrayField(parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullStringArrayField) WithAlias(alias string) NullStringArrayField {
+	return NullStringArrayField(parseColumn(string(f)).WithTable(alias).String())
+}
@@ -0,0 +1,50 @@
+package qm
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+)
+
+func TestPgArray(t *testing.T) {
+	tests := []struct {
+		name string
+		in   interface{}
+		want string
+	}{
+		{"nil", []int(nil), "{}"},
+		{"ints", []int{1, -2, 3}, "{1,-2,3}"},
+		{"int64s", []int64{math.MaxInt64}, "{9223372036854775807}"},
+		{"floats", []float64{1.5, 1e21}, "{1.5,1e+21}"},
+		{"special floats", []float64{math.NaN(), math.Inf(1), math.Inf(-1)}, "{NaN,Infinity,-Infinity}"},
+		{"strings", []string{"a", "", "NULL"}, `{"a","","NULL"}`},
+		{"escaped strings", []string{`a"b`, `c\d`, "{,}"}, `{"a\"b","c\\d","{,}"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pgArray(tt.in); got != tt.want {
+				t.Errorf("pgArray(%#v) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArrayFields(t *testing.T) {
+	ids := IntArrayField("ids")
+	tags := StringArrayField("tags")
+	runConditionTests(t, []conditionTest{
+		{"equals", ids.Equals([]int{1, 2}), "ids = ?::bigint[]", []interface{}{"{1,2}"}},
+		{"equals nil", ids.Equals(nil), "ids = ?::bigint[]", []interface{}{"{}"}},
+		{"not equals", Int64ArrayField("ids").NotEquals([]int64{1}), "ids != ?::bigint[]", []interface{}{"{1}"}},
+		{"contains", tags.Contains("a", "b"), "tags @> ?::text[]", []interface{}{`{"a","b"}`}},
+		{"contained by", Float64ArrayField("xs").ContainedBy(0.5), "xs <@ ?::double precision[]", []interface{}{"{0.5}"}},
+		{"overlaps", tags.Overlaps(`"`), "tags && ?::text[]", []interface{}{`{"\""}`}},
+		{"has element", tags.HasElement("a"), "? = ANY (tags)", []interface{}{"a"}},
+		{"length", ids.Length().GreaterThan(2), "cardinality(ids) > ?", []interface{}{2}},
+		{"null", NullStringArrayField("tags").Equals(nil), "tags IS NULL", nil},
+		{"null not equals", NullIntArrayField("ids").NotEquals([]int{1}), "ids != ?::bigint[]", []interface{}{"{1}"}},
+		{"alias", ids.WithAlias("u").Contains(1), "u.ids @> ?::bigint[]", []interface{}{"{1}"}},
+		{"valuer", tags.EqualsValuer(sql.NullString{String: "{a}", Valid: true}), "tags = ?", []interface{}{sql.NullString{String: "{a}", Valid: true}}},
+		{"null valuer", NullStringArrayField("tags").EqualsValuer(sql.NullString{}), "tags IS NULL", nil},
+	})
+}
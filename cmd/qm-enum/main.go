@@ -0,0 +1,341 @@
+// Command qm-enum generates enum fields for Go string types whose values are
+// declared as constants, so that conditions on status or type columns only
+// accept values of that type.
+//
+// Given
+//
+//	type Status string
+//
+//	const (
+//		StatusActive   Status = "active"
+//		StatusDisabled Status = "disabled"
+//	)
+//
+// running
+//
+//	//go:generate qm-enum -type=Status
+//
+// writes status_qm.go declaring StatusEnum, a *qm.Enum holding the labels of
+// Status, and the StatusField and NullStatusField types whose Equals, In,
+// NotIn and other methods take Status values, along with AllowedValues. Use
+// StatusEnum.Check to verify that the labels of a PostgreSQL enum type match
+// the constants.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var (
+	typeNames = flag.String("type", "", "comma-separated list of type names; must be set")
+	output    = flag.String("output", "", "output file name; default srcdir/<type>_qm.go")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: qm-enum -type T [-output file] [directory]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("qm-enum: ")
+	flag.Usage = usage
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	pkg, err := parsePackage(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var enums []enum
+	for _, name := range strings.Split(*typeNames, ",") {
+		e, err := pkg.enum(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		enums = append(enums, e)
+	}
+
+	src, err := generate(pkg.name, *typeNames, enums)
+	if err != nil {
+		log.Fatal(err)
+	}
+	out := *output
+	if out == "" {
+		out = filepath.Join(dir, strings.ToLower(enums[0].Type)+"_qm.go")
+	}
+	if err := ioutil.WriteFile(out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// pkg is a parsed package, excluding its tests.
+type pkg struct {
+	name  string
+	files []*ast.File
+}
+
+func parsePackage(dir string) (*pkg, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%d packages found in %s", len(pkgs), dir)
+	}
+	p := &pkg{}
+	for name, astPkg := range pkgs {
+		p.name = name
+		// Files are sorted so that constants keep their declaration order.
+		names := make([]string, 0, len(astPkg.Files))
+		for fn := range astPkg.Files {
+			names = append(names, fn)
+		}
+		sort.Strings(names)
+		for _, fn := range names {
+			p.files = append(p.files, astPkg.Files[fn])
+		}
+	}
+	return p, nil
+}
+
+// enum is a type and its constants, in declaration order.
+type enum struct {
+	Type   string
+	Consts []string
+	Labels []string
+}
+
+func (p *pkg) enum(typ string) (enum, error) {
+	e := enum{Type: typ}
+	found := false
+	for _, f := range p.files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if spec.Name.Name == typ {
+						if id, ok := spec.Type.(*ast.Ident); !ok || id.Name != "string" {
+							return e, fmt.Errorf("type %s is not a string type", typ)
+						}
+						found = true
+					}
+				case *ast.ValueSpec:
+					if gen.Tok != token.CONST {
+						continue
+					}
+					if id, ok := spec.Type.(*ast.Ident); !ok || id.Name != typ {
+						continue
+					}
+					if len(spec.Values) != len(spec.Names) {
+						return e, fmt.Errorf("constants %s of type %s must each be given a value", spec.Names[0].Name, typ)
+					}
+					for i, name := range spec.Names {
+						lit, ok := spec.Values[i].(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING {
+							return e, fmt.Errorf("constant %s of type %s must be a string literal", name.Name, typ)
+						}
+						label, err := strconv.Unquote(lit.Value)
+						if err != nil {
+							return e, err
+						}
+						if name.Name == "_" {
+							continue
+						}
+						e.Consts = append(e.Consts, name.Name)
+						e.Labels = append(e.Labels, label)
+					}
+				}
+			}
+		}
+	}
+	if !found {
+		return e, fmt.Errorf("type %s not found", typ)
+	}
+	if len(e.Consts) == 0 {
+		return e, fmt.Errorf("no constants of type %s found", typ)
+	}
+	return e, nil
+}
+
+func generate(pkgName, types string, enums []enum) ([]byte, error) {
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, struct {
+		Package string
+		Types   string
+		Enums   []enum
+	}{pkgName, types, enums})
+	if err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return src, nil
+}
+
+var tmpl = template.Must(template.New("enum").Parse(`// Code generated by "qm-enum -type={{.Types}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"database/sql/driver"
+
+	qm "github.com/vahanerevan/vm-qm"
+)
+{{range .Enums}}{{$t := .Type}}
+// {{$t}}Enum holds the labels of {{$t}}.
+var {{$t}}Enum = qm.NewEnum("{{$t}}"{{range .Consts}}, string({{.}}){{end}})
+
+// {{$t}}Field is a component that returns a WhereClause that contains a
+// comparison based on its field and a {{$t}} value.
+type {{$t}}Field string
+
+type Null{{$t}}Field string
+
+// AllowedValues returns the values of {{$t}} in declaration order.
+func (f {{$t}}Field) AllowedValues() []{{$t}} {
+	return []{{$t}}{ {{- range $i, $c := .Consts}}{{if $i}}, {{end}}{{$c}}{{end -}} }
+}
+func (f Null{{$t}}Field) AllowedValues() []{{$t}} {
+	return {{$t}}Field(f).AllowedValues()
+}
+
+func (f {{$t}}Field) ToValue(v {{$t}}) qm.Condition {
+	return qm.CondWithoutAlias(string(f), qm.OpEquals, {{$t}}Enum.Arg(v))
+}
+
+func (f Null{{$t}}Field) ToNullValue(v *{{$t}}) qm.Condition {
+	return qm.CondWithoutAlias(string(f), qm.OpEquals, {{$t}}Enum.NullArg(v))
+}
+
+func (f {{$t}}Field) Equals(v {{$t}}) qm.Condition {
+	return qm.Cond(string(f), qm.OpEquals, {{$t}}Enum.Arg(v))
+}
+func (f Null{{$t}}Field) Equals(v *{{$t}}) qm.Condition {
+	return qm.CondNullable(string(f), qm.OpEquals, {{$t}}Enum.NullArg(v))
+}
+
+func (f {{$t}}Field) In(vs ...{{$t}}) qm.Condition {
+	return qm.CondList(string(f), qm.OpIN, {{$t}}Enum.Args(vs))
+}
+func (f Null{{$t}}Field) In(vs ...*{{$t}}) qm.Condition {
+	return qm.CondList(string(f), qm.OpIN, {{$t}}Enum.Args(vs))
+}
+
+func (f {{$t}}Field) IsNotNull() qm.Condition {
+	return qm.Cond(string(f), qm.OpIsNotNull, nil)
+}
+func (f Null{{$t}}Field) IsNotNull() qm.Condition {
+	return qm.Cond(string(f), qm.OpIsNotNull, nil)
+}
+func (f Null{{$t}}Field) IsNull() qm.Condition {
+	return qm.Cond(string(f), qm.OpIsNull, nil)
+}
+
+func (f {{$t}}Field) NotEquals(v {{$t}}) qm.Condition {
+	return qm.Cond(string(f), qm.OpNotEquals, {{$t}}Enum.Arg(v))
+}
+func (f Null{{$t}}Field) NotEquals(v *{{$t}}) qm.Condition {
+	return qm.CondNullable(string(f), qm.OpNotEquals, {{$t}}Enum.NullArg(v))
+}
+
+func (f {{$t}}Field) EqualsValuer(v driver.Valuer) qm.Condition {
+	return qm.CondNullable(string(f), qm.OpEquals, {{$t}}Enum.Arg(v))
+}
+func (f Null{{$t}}Field) EqualsValuer(v driver.Valuer) qm.Condition {
+	return qm.CondNullable(string(f), qm.OpEquals, {{$t}}Enum.Arg(v))
+}
+
+func (f {{$t}}Field) NotEqualsValuer(v driver.Valuer) qm.Condition {
+	return qm.CondNullable(string(f), qm.OpNotEquals, {{$t}}Enum.Arg(v))
+}
+func (f Null{{$t}}Field) NotEqualsValuer(v driver.Valuer) qm.Condition {
+	return qm.CondNullable(string(f), qm.OpNotEquals, {{$t}}Enum.Arg(v))
+}
+
+func (f Null{{$t}}Field) IsDistinctFrom(v *{{$t}}) qm.Condition {
+	return qm.Cond(string(f), qm.OpIsDistinctFrom, {{$t}}Enum.NullArg(v))
+}
+func (f Null{{$t}}Field) IsNotDistinctFrom(v *{{$t}}) qm.Condition {
+	return qm.Cond(string(f), qm.OpIsNotDistinctFrom, {{$t}}Enum.NullArg(v))
+}
+
+func (f {{$t}}Field) NotIn(vs ...{{$t}}) qm.Condition {
+	return qm.CondList(string(f), qm.OpNotIN, {{$t}}Enum.Args(vs))
+}
+func (f Null{{$t}}Field) NotIn(vs ...*{{$t}}) qm.Condition {
+	return qm.CondList(string(f), qm.OpNotIN, {{$t}}Enum.Args(vs))
+}
+
+func (f {{$t}}Field) InValuers(vs ...driver.Valuer) qm.Condition {
+	return qm.CondList(string(f), qm.OpIN, {{$t}}Enum.Args(vs))
+}
+func (f Null{{$t}}Field) InValuers(vs ...driver.Valuer) qm.Condition {
+	return qm.CondList(string(f), qm.OpIN, {{$t}}Enum.Args(vs))
+}
+
+func (f {{$t}}Field) NotInValuers(vs ...driver.Valuer) qm.Condition {
+	return qm.CondList(string(f), qm.OpNotIN, {{$t}}Enum.Args(vs))
+}
+func (f Null{{$t}}Field) NotInValuers(vs ...driver.Valuer) qm.Condition {
+	return qm.CondList(string(f), qm.OpNotIN, {{$t}}Enum.Args(vs))
+}
+
+func (f {{$t}}Field) InQuery(sub qm.Subquery) qm.Condition {
+	return qm.CondQuery(string(f), qm.OpIN, sub)
+}
+func (f Null{{$t}}Field) InQuery(sub qm.Subquery) qm.Condition {
+	return qm.CondQuery(string(f), qm.OpIN, sub)
+}
+
+func (f {{$t}}Field) NotInQuery(sub qm.Subquery) qm.Condition {
+	return qm.CondQuery(string(f), qm.OpNotIN, sub)
+}
+func (f Null{{$t}}Field) NotInQuery(sub qm.Subquery) qm.Condition {
+	return qm.CondQuery(string(f), qm.OpNotIN, sub)
+}
+
+func (f {{$t}}Field) Ref() qm.ColumnRef {
+	return qm.ParseColumn(string(f))
+}
+func (f Null{{$t}}Field) Ref() qm.ColumnRef {
+	return qm.ParseColumn(string(f))
+}
+
+func (f {{$t}}Field) WithAlias(alias string) {{$t}}Field {
+	return {{$t}}Field(qm.ParseColumn(string(f)).WithTable(alias).String())
+}
+func (f Null{{$t}}Field) WithAlias(alias string) Null{{$t}}Field {
+	return Null{{$t}}Field(qm.ParseColumn(string(f)).WithTable(alias).String())
+}
+{{end}}`))
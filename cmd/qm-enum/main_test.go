@@ -0,0 +1,94 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEnum(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    string
+		consts []string
+		labels []string
+		err    string
+	}{
+		{
+			name: "const block",
+			src: `type Status string
+const (
+	StatusActive   Status = "active"
+	_              Status = "skipped"
+	StatusDisabled Status = "dis\"abled"
+)
+const Other = "other"`,
+			consts: []string{"StatusActive", "StatusDisabled"},
+			labels: []string{"active", `dis"abled`},
+		},
+		{
+			name: "single consts",
+			src: `type Status string
+const StatusA, StatusB Status = "a", "b"`,
+			consts: []string{"StatusA", "StatusB"},
+			labels: []string{"a", "b"},
+		},
+		{name: "missing type", src: `const StatusA Status = "a"`, err: "type Status not found"},
+		{name: "not a string", src: `type Status int`, err: "not a string type"},
+		{name: "no constants", src: `type Status string`, err: "no constants"},
+		{name: "iota", src: "type Status string\nconst StatusA Status = Status(\"a\")", err: "must be a string literal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := ioutil.WriteFile(filepath.Join(dir, "status.go"), []byte("package model\n"+tt.src), 0644); err != nil {
+				t.Fatal(err)
+			}
+			p, err := parsePackage(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e, err := p.enum("Status")
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(e.Consts, tt.consts) || !reflect.DeepEqual(e.Labels, tt.labels) {
+				t.Errorf("got %q, %q, want %q, %q", e.Consts, e.Labels, tt.consts, tt.labels)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := generate("model", "Status", []enum{{
+		Type:   "Status",
+		Consts: []string{"StatusActive", "StatusDisabled"},
+		Labels: []string{"active", "disabled"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`// Code generated by "qm-enum -type=Status"; DO NOT EDIT.`,
+		"package model",
+		`var StatusEnum = qm.NewEnum("Status", string(StatusActive), string(StatusDisabled))`,
+		"type StatusField string",
+		"type NullStatusField string",
+		"return []Status{StatusActive, StatusDisabled}",
+		"func (f StatusField) In(vs ...Status) qm.Condition {",
+		"func (f NullStatusField) Equals(v *Status) qm.Condition {",
+		"func (f StatusField) InValuers(vs ...driver.Valuer) qm.Condition {",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code lacks %q", want)
+		}
+	}
+}
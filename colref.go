@@ -0,0 +1,233 @@
+package qm
+
+import (
+	"strings"
+	"sync"
+)
+
+// ColumnRef is a parsed field name: a column, optionally qualified by a
+// table or alias and a schema, and optionally followed by a JSON path as in
+// u.attrs->'address'->>'city'. Identifiers are kept as declared, quotes
+// included, and path elements are kept as the SQL literals they were
+// declared with.
+//
+// A reference can also be cast, as in (attrs->>'price')::numeric. Field
+// names that are any other expression, such as lower(name), are kept whole and
+// never requalified or quoted.
+type ColumnRef struct {
+	Schema string
+	Table  string
+	Column string
+	// Path holds the elements of a JSON path applied to the column with ->,
+	// the last one being applied with ->> when Text is set.
+	Path []string
+	Text bool
+	// Cast is the type the reference is cast to, if any.
+	Cast string
+
+	expr string
+}
+
+// maxColumnRefs bounds the number of field names cached by parseColumn.
+const maxColumnRefs = 4096
+
+// columnRefs caches parsed field names. Declared fields are few, but names
+// built at run time, such as with Ident, are not, so the cache is emptied
+// whenever it is full instead of growing without bound.
+var columnRefs = struct {
+	sync.RWMutex
+	refs map[string]ColumnRef
+}{refs: make(map[string]ColumnRef)}
+
+// parseColumn returns the parsed form of a field name. Field names are
+// parsed the first time they are rendered and cached from then on.
+func parseColumn(name string) ColumnRef {
+	columnRefs.RLock()
+	ref, ok := columnRefs.refs[name]
+	columnRefs.RUnlock()
+	if ok {
+		return ref
+	}
+	ref = ParseColumn(name)
+	columnRefs.Lock()
+	if len(columnRefs.refs) >= maxColumnRefs {
+		columnRefs.refs = make(map[string]ColumnRef)
+	}
+	columnRefs.refs[name] = ref
+	columnRefs.Unlock()
+	return ref
+}
+
+// ParseColumn parses a field name into a ColumnRef.
+func ParseColumn(name string) ColumnRef {
+	if i := strings.LastIndex(name, ")::"); strings.HasPrefix(name, "(") && i > 0 {
+		ref, cast := ParseColumn(name[1:i]), name[i+3:]
+		if ref.IsExpr() || ref.Cast != "" || !isPlainIdent(cast) {
+			return ColumnRef{expr: name}
+		}
+		ref.Cast = cast
+		return ref
+	}
+	ident, path := name, ""
+	if i := indexOutsideQuotes(name, "->"); i >= 0 {
+		ident, path = strings.TrimSpace(name[:i]), name[i:]
+	}
+	parts, ok := splitIdent(ident)
+	if !ok || len(parts) > 3 {
+		return ColumnRef{expr: name}
+	}
+	var ref ColumnRef
+	ref.Column = parts[len(parts)-1]
+	if len(parts) > 1 {
+		ref.Table = parts[len(parts)-2]
+	}
+	if len(parts) > 2 {
+		ref.Schema = parts[0]
+	}
+	if ref.Column == "*" && path != "" {
+		return ColumnRef{expr: name}
+	}
+	if ref.Path, ref.Text, ok = parseJSONPath(path); !ok {
+		return ColumnRef{expr: name}
+	}
+	return ref
+}
+
+// parseJSONPath parses a sequence of -> and ->> operators each followed by
+// a string literal or an integer index.
+func parseJSONPath(path string) ([]string, bool, bool) {
+	var elems []string
+	text := false
+	for path = strings.TrimSpace(path); path != ""; path = strings.TrimSpace(path) {
+		if text || !strings.HasPrefix(path, "->") {
+			return nil, false, false
+		}
+		path = path[2:]
+		if strings.HasPrefix(path, ">") {
+			text = true
+			path = path[1:]
+		}
+		path = strings.TrimSpace(path)
+		end := 0
+		if strings.HasPrefix(path, "'") {
+			end = 1
+			for {
+				i := strings.IndexByte(path[end:], '\'')
+				if i < 0 {
+					return nil, false, false
+				}
+				end += i + 1
+				if end < len(path) && path[end] == '\'' {
+					end++
+					continue
+				}
+				break
+			}
+		} else {
+			for end < len(path) && path[end] >= '0' && path[end] <= '9' {
+				end++
+			}
+			if end == 0 {
+				return nil, false, false
+			}
+		}
+		elems = append(elems, path[:end])
+		path = path[end:]
+	}
+	return elems, text, true
+}
+
+// indexOutsideQuotes returns the index of the first occurrence of sep in s
+// that is not inside a quoted identifier or string, or -1.
+func indexOutsideQuotes(s, sep string) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '"' || s[i] == '`':
+			quote = s[i]
+		case strings.HasPrefix(s[i:], sep):
+			return i
+		}
+	}
+	return -1
+}
+
+// IsExpr reports whether the field name was not a column reference and is
+// kept as an opaque expression.
+func (r ColumnRef) IsExpr() bool {
+	return r.expr != ""
+}
+
+// Unaliased returns the reference without its table and schema, as needed
+// by the SET clause of an UPDATE.
+func (r ColumnRef) Unaliased() ColumnRef {
+	if r.IsExpr() {
+		return r
+	}
+	r.Schema, r.Table = "", ""
+	return r
+}
+
+// WithTable returns the reference qualified by table instead, such as the
+// alias a table is given in a join.
+func (r ColumnRef) WithTable(table string) ColumnRef {
+	if r.IsExpr() {
+		return r
+	}
+	r.Schema, r.Table = "", table
+	return r
+}
+
+// String returns the reference in the form fields are declared with.
+func (r ColumnRef) String() string {
+	return r.render(NoQuoting)
+}
+
+func (r ColumnRef) render(q Quoting) string {
+	if r.IsExpr() {
+		return r.expr
+	}
+	var sb strings.Builder
+	if r.Cast != "" {
+		sb.WriteByte('(')
+	}
+	for _, part := range []string{r.Schema, r.Table} {
+		if part != "" {
+			sb.WriteString(quotePart(q, part))
+			sb.WriteByte('.')
+		}
+	}
+	sb.WriteString(quotePart(q, r.Column))
+	for i, elem := range r.Path {
+		if r.Text && i == len(r.Path)-1 {
+			sb.WriteString("->>")
+		} else {
+			sb.WriteString("->")
+		}
+		sb.WriteString(elem)
+	}
+	if r.Cast != "" {
+		sb.WriteString(")::" + r.Cast)
+	}
+	return sb.String()
+}
+
+// quotePart quotes an identifier with q if it must be: parts declared
+// quoted are requoted with q and reserved words are quoted, while other
+// identifiers are written as declared so that the database folds their case
+// as it did for the unquoted names the schema was created with.
+func quotePart(q Quoting, part string) string {
+	switch {
+	case part == "*" || q == NoQuoting:
+		return part
+	case isQuoted(part):
+		return q.quote(unquote(part))
+	case needsQuotes(part):
+		return q.quote(part)
+	}
+	return part
+}
@@ -0,0 +1,71 @@
+package qm
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestParseColumn(t *testing.T) {
+	tests := []struct {
+		name string
+		want ColumnRef
+	}{
+		{"id", ColumnRef{Column: "id"}},
+		{"u.id", ColumnRef{Table: "u", Column: "id"}},
+		{"public.users.id", ColumnRef{Schema: "public", Table: "users", Column: "id"}},
+		{`"My Schema"."T".id`, ColumnRef{Schema: `"My Schema"`, Table: `"T"`, Column: "id"}},
+		{"u.attrs->'address'->>'city'", ColumnRef{Table: "u", Column: "attrs", Path: []string{"'address'", "'city'"}, Text: true}},
+		{"tags -> 0", ColumnRef{Column: "tags", Path: []string{"0"}}},
+		{"(attrs->>'price')::numeric", ColumnRef{Column: "attrs", Path: []string{"'price'"}, Text: true, Cast: "numeric"}},
+		{"lower(name)", ColumnRef{expr: "lower(name)"}},
+		{"a.b.c.d", ColumnRef{expr: "a.b.c.d"}},
+		{"x->>'a'->'b'", ColumnRef{expr: "x->>'a'->'b'"}},
+		{"u.*->'a'", ColumnRef{expr: "u.*->'a'"}},
+	}
+	for _, tt := range tests {
+		if got := ParseColumn(tt.name); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseColumn(%q) = %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestColumnRefString(t *testing.T) {
+	tests := []struct {
+		name, unaliased, withTable string
+	}{
+		{"id", "id", "t.id"},
+		{"u.id", "id", "t.id"},
+		{"public.users.id", "id", "t.id"},
+		{"u.attrs->'a'->>'b'", "attrs->'a'->>'b'", "t.attrs->'a'->>'b'"},
+		{"(u.attrs->>'n')::int", "(attrs->>'n')::int", "(t.attrs->>'n')::int"},
+		{"lower(u.name)", "lower(u.name)", "lower(u.name)"},
+	}
+	for _, tt := range tests {
+		ref := ParseColumn(tt.name)
+		if got := ref.String(); got != tt.name {
+			t.Errorf("ParseColumn(%q).String() = %q", tt.name, got)
+		}
+		if got := ref.Unaliased().String(); got != tt.unaliased {
+			t.Errorf("ParseColumn(%q).Unaliased() = %q, want %q", tt.name, got, tt.unaliased)
+		}
+		if got := ref.WithTable("t").String(); got != tt.withTable {
+			t.Errorf("ParseColumn(%q).WithTable(t) = %q, want %q", tt.name, got, tt.withTable)
+		}
+	}
+}
+
+func TestColumnRefCacheIsBounded(t *testing.T) {
+	for i := 0; i < 2*maxColumnRefs; i++ {
+		parseColumn("c" + strconv.Itoa(i))
+	}
+	columnRefs.RLock()
+	n := len(columnRefs.refs)
+	columnRefs.RUnlock()
+	if n > maxColumnRefs {
+		t.Errorf("cache holds %d names, want at most %d", n, maxColumnRefs)
+	}
+	if got := parseColumn("u.id"); got.Table != "u" || got.Column != "id" {
+		t.Errorf("parseColumn(u.id) = %#v", got)
+	}
+}
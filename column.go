@@ -0,0 +1,35 @@
+package qm
+
+import "time"
+
+// NumericColumn is an SQL expression of a numeric type. It is implemented by
+// the integer and floating point fields so that they can be compared with one
+// another without binding any parameter.
+type NumericColumn interface {
+	buildNumeric(b *builder)
+}
+
+// ComplexColumn is an SQL expression of a complex type, implemented by the
+// complex fields. Complex values are not mixed with real numbers and have no
+// order, so complex columns are only compared for equality with one another.
+type ComplexColumn interface {
+	buildComplex(b *builder)
+}
+
+// StringColumn is an SQL expression of a string type, implemented by
+// StringField and NullStringField.
+type StringColumn interface {
+	buildString(b *builder)
+}
+
+// BoolColumn is an SQL expression of a boolean type, implemented by BoolField
+// and NullBoolField.
+type BoolColumn = Column[bool]
+
+// TimeColumn is an SQL expression of a timestamp type, implemented by
+// TimeField and NullTimeField.
+type TimeColumn = Column[time.Time]
+
+// DateColumn is an SQL expression of a date type, implemented by DateField
+// and NullDateField.
+type DateColumn = Column[Date]
@@ -0,0 +1,514 @@
+package qm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Condition is a SQL predicate together with the arguments bound to its
+// placeholders. Conditions are returned by the typed field methods and can be
+// nested arbitrarily with And, Or and Not.
+type Condition struct {
+	build    func(b *builder)
+	compound bool
+}
+
+// builder accumulates the SQL and the ordered arguments of a condition tree,
+// along with the first error met while building it. An inline builder writes
+// arguments as literals instead, for Debug.
+type builder struct {
+	sql     strings.Builder
+	args    []interface{}
+	err     error
+	dialect Dialect
+	inline  bool
+}
+
+func (b *builder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+func (b *builder) writeString(s string) {
+	b.sql.WriteString(s)
+}
+
+func (b *builder) writeColumn(name string) {
+	b.sql.WriteString(parseColumn(name).render(b.dialect.Quoting))
+}
+
+func (b *builder) writeArg(v interface{}) {
+	if a, ok := v.(castArg); ok {
+		value := a.value
+		switch {
+		case a.err != nil:
+			b.setErr(a.err)
+		case a.convert != nil:
+			if converted, err := a.convert(value); err != nil {
+				b.setErr(err)
+			} else {
+				value = converted
+			}
+		}
+		b.writeArg(value)
+		if a.cast != "" {
+			b.writeString("::" + a.cast)
+		}
+		return
+	}
+	if b.inline {
+		b.sql.WriteString(literal(v))
+		return
+	}
+	b.args = append(b.args, v)
+	b.sql.WriteString(b.dialect.Placeholder.format(len(b.args)))
+}
+
+// writeOperand writes op, escaping the question marks of operators such as
+// the jsonb ?| so that they are not taken for placeholders.
+func (b *builder) writeOperand(op Operand) {
+	parts := strings.Split(string(op), "?")
+	for i, part := range parts {
+		if i > 0 {
+			b.writeQuestionMark()
+		}
+		b.writeString(part)
+	}
+}
+
+// castArg is an argument bound with an explicit cast, as in ?::uuid, or
+// without any when cast is empty. Values that cannot be converted are bound
+// as they are, never as NULL, err being reported as the condition's error.
+type castArg struct {
+	value   interface{}
+	cast    string
+	convert func(v interface{}) (interface{}, error)
+	err     error
+}
+
+// newCastArg returns v converted by convert and bound with cast. Conversion
+// is done right away, so that an invalid value is reported where the
+// condition is built: it panics when Strict is set, and is reported by the
+// condition's Err otherwise. Values given as a driver.Valuer are converted
+// when the condition is rendered instead, as their Value is until then.
+func newCastArg(v interface{}, cast string, convert func(v interface{}) (interface{}, error)) castArg {
+	a := castArg{value: v, cast: cast}
+	if _, ok := v.(driver.Valuer); ok {
+		a.convert = convert
+		return a
+	}
+	converted, err := convert(v)
+	if err != nil {
+		if Strict {
+			panic(err)
+		}
+		a.err = err
+		return a
+	}
+	a.value = converted
+	return a
+}
+
+// writeQuestionMark writes a literal question mark, such as the jsonb ?
+// operator, escaped if the dialect requires it.
+func (b *builder) writeQuestionMark() {
+	if b.dialect.EscapeQuestionMarks && !b.inline {
+		b.sql.WriteString(`\?`)
+		return
+	}
+	b.sql.WriteByte('?')
+}
+
+// writeCondition renders c, parenthesizing it when it is made of several
+// conditions joined by AND or OR.
+func (b *builder) writeCondition(c Condition) {
+	if c.compound {
+		b.writeString("(")
+		c.build(b)
+		b.writeString(")")
+		return
+	}
+	c.build(b)
+}
+
+var (
+	alwaysTrue  = Condition{build: func(b *builder) { b.writeString("1 = 1") }}
+	alwaysFalse = Condition{build: func(b *builder) { b.writeString("1 = 0") }}
+)
+
+// expr renders an SQL expression, such as a column or an arithmetic
+// expression, that conditions are built upon.
+type expr func(b *builder)
+
+func column(name string) expr {
+	return func(b *builder) {
+		b.writeColumn(name)
+	}
+}
+
+// Cond returns a condition comparing col to value using op. Unary operands
+// ignore value and bind no argument; list operands expect value to be a slice
+// and are handled by CondList; ternary operands expect a two-element slice
+// holding the lower and upper bound and are handled by CondBetween.
+func Cond(col string, op Operand, value interface{}) Condition {
+	return compare(column(col), op, value)
+}
+
+func compare(lhs expr, op Operand, value interface{}) Condition {
+	switch op.Arity() {
+	case Unary:
+		return Condition{build: func(b *builder) {
+			lhs(b)
+			b.writeString(" ")
+			b.writeOperand(op)
+		}}
+	case List:
+		if k := reflect.ValueOf(value).Kind(); k != reflect.Slice && k != reflect.Array {
+			value = []interface{}{value}
+		}
+		return compareList(lhs, op, value)
+	case Ternary:
+		bounds := reflect.ValueOf(value)
+		if k := bounds.Kind(); k != reflect.Slice && k != reflect.Array || bounds.Len() != 2 {
+			return Condition{build: func(b *builder) {
+				b.setErr(fmt.Errorf("qm: operand %s expects a slice of two bounds, got %#v", op, value))
+			}}
+		}
+		return compareBetween(lhs, op, bounds.Index(0).Interface(), bounds.Index(1).Interface())
+	}
+	return Condition{build: func(b *builder) {
+		lhs(b)
+		b.writeString(" ")
+		b.writeOperand(op)
+		b.writeString(" ")
+		b.writeArg(value)
+	}}
+}
+
+// compareExpr compares lhs to another expression, binding no argument.
+func compareExpr(lhs expr, op Operand, rhs expr) Condition {
+	return Condition{build: func(b *builder) {
+		lhs(b)
+		b.writeString(" ")
+		b.writeOperand(op)
+		b.writeString(" ")
+		rhs(b)
+	}}
+}
+
+// CondWithoutAlias is like Cond but strips the table alias and schema from
+// col, as required by SET clauses.
+func CondWithoutAlias(col string, op Operand, value interface{}) Condition {
+	return Cond(parseColumn(col).Unaliased().String(), op, value)
+}
+
+// CondNullable is like Cond but compares against NULL correctly: a nil value
+// turns OpEquals into IS NULL and OpNotEquals into IS NOT NULL instead of
+// binding a NULL argument that never matches. Values given as a
+// driver.Valuer, such as sql.NullString, are NULL when their Value is nil,
+// which is checked when the condition is rendered.
+func CondNullable(col string, op Operand, value interface{}) Condition {
+	if v, ok := valuerOf(value); ok {
+		return condValuer(col, op, value, v)
+	}
+	return condNullable(col, op, value)
+}
+
+func condNullable(col string, op Operand, value interface{}) Condition {
+	if isNilValue(value) {
+		switch op {
+		case OpEquals:
+			return Cond(col, OpIsNull, nil)
+		case OpNotEquals:
+			return Cond(col, OpIsNotNull, nil)
+		}
+	}
+	return Cond(col, op, value)
+}
+
+// CondList returns a condition comparing col against the elements of the
+// slice vs using a list operator such as OpIN or OpNotIN, with one
+// placeholder per element. Nil elements never match inside a list, so they
+// are turned into an IS NULL (IS NOT NULL for OpNotIN) check instead, as are
+// elements given as a driver.Valuer whose Value is nil, which is checked when
+// the condition is rendered. An empty list yields a condition that is always
+// false for OpIN and always true for OpNotIN.
+func CondList(col string, op Operand, vs interface{}) Condition {
+	return compareList(column(col), op, vs)
+}
+
+func compareList(lhs expr, op Operand, vs interface{}) Condition {
+	rv := reflect.ValueOf(vs)
+	if k := rv.Kind(); k != reflect.Slice && k != reflect.Array {
+		return Condition{build: func(b *builder) {
+			b.setErr(fmt.Errorf("qm: operand %s expects a slice, got %T", op, vs))
+		}}
+	}
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+	if hasValuer(values...) {
+		return deferValuers(func(b *builder) Condition {
+			resolved := make([]interface{}, len(values))
+			for i, v := range values {
+				resolved[i] = resolveArg(b, v)
+			}
+			return compareValues(lhs, op, resolved)
+		})
+	}
+	return compareValues(lhs, op, values)
+}
+
+func compareValues(lhs expr, op Operand, vs []interface{}) Condition {
+	values := make([]interface{}, 0, len(vs))
+	hasNil := false
+	for _, v := range vs {
+		if isNilValue(v) {
+			hasNil = true
+			continue
+		}
+		values = append(values, v)
+	}
+	negated := op == OpNotIN
+
+	var list Condition
+	if len(values) != 0 {
+		list = Condition{build: func(b *builder) {
+			lhs(b)
+			b.writeString(" ")
+			b.writeOperand(op)
+			b.writeString(" (")
+			for i, v := range values {
+				if i > 0 {
+					b.writeString(", ")
+				}
+				b.writeArg(v)
+			}
+			b.writeString(")")
+		}}
+	}
+	switch {
+	case hasNil && negated:
+		return And(list, compare(lhs, OpIsNotNull, nil))
+	case hasNil:
+		return Or(list, compare(lhs, OpIsNull, nil))
+	case len(values) == 0 && negated:
+		return alwaysTrue
+	case len(values) == 0:
+		return alwaysFalse
+	}
+	return list
+}
+
+// CondBetween returns a condition checking that col lies between lo and hi,
+// both inclusive, using OpBetween or OpNotBetween. A nil bound is treated as
+// unbounded, so optional range filters can be passed through directly: with
+// only one bound the condition degrades to a single comparison, and with
+// neither it always holds for OpBetween and never holds for OpNotBetween.
+// Bounds given as a driver.Valuer are unbounded when their Value is nil,
+// which is checked when the condition is rendered.
+func CondBetween(col string, op Operand, lo, hi interface{}) Condition {
+	return compareBetween(column(col), op, lo, hi)
+}
+
+func compareBetween(lhs expr, op Operand, lo, hi interface{}) Condition {
+	if hasValuer(lo, hi) {
+		return deferValuers(func(b *builder) Condition {
+			return compareBounds(lhs, op, resolveArg(b, lo), resolveArg(b, hi))
+		})
+	}
+	return compareBounds(lhs, op, lo, hi)
+}
+
+func compareBounds(lhs expr, op Operand, lo, hi interface{}) Condition {
+	noLo, noHi := isNilValue(lo), isNilValue(hi)
+	negated := op == OpNotBetween
+	switch {
+	case noLo && noHi && negated:
+		return alwaysFalse
+	case noLo && noHi:
+		return alwaysTrue
+	case noLo && negated:
+		return compare(lhs, OpGreater, hi)
+	case noLo:
+		return compare(lhs, OpLessEquals, hi)
+	case noHi && negated:
+		return compare(lhs, OpLess, lo)
+	case noHi:
+		return compare(lhs, OpGreaterEquals, lo)
+	}
+	return Condition{build: func(b *builder) {
+		lhs(b)
+		b.writeString(" ")
+		b.writeOperand(op)
+		b.writeString(" ")
+		b.writeArg(lo)
+		b.writeString(" AND ")
+		b.writeArg(hi)
+	}}
+}
+
+// CondRange returns a condition checking that col lies in the half-open
+// range [lo, hi). As with CondBetween a nil bound is treated as unbounded.
+func CondRange(col string, lo, hi interface{}) Condition {
+	return compareRange(column(col), lo, hi)
+}
+
+func compareRange(lhs expr, lo, hi interface{}) Condition {
+	if hasValuer(lo, hi) {
+		return deferValuers(func(b *builder) Condition {
+			return compareHalfOpen(lhs, resolveArg(b, lo), resolveArg(b, hi))
+		})
+	}
+	return compareHalfOpen(lhs, lo, hi)
+}
+
+func compareHalfOpen(lhs expr, lo, hi interface{}) Condition {
+	var from, to Condition
+	if !isNilValue(lo) {
+		from = compare(lhs, OpGreaterEquals, lo)
+	}
+	if !isNilValue(hi) {
+		to = compare(lhs, OpLess, hi)
+	}
+	return And(from, to)
+}
+
+func isNilValue(v interface{}) bool {
+	return v == nil || isNil(reflect.ValueOf(v))
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
+}
+
+// And returns a condition that holds when all of conds hold. Zero-value
+// conditions are ignored; And without any conditions is always true.
+func And(conds ...Condition) Condition {
+	return join(" AND ", alwaysTrue, conds)
+}
+
+// Or returns a condition that holds when any of conds holds. Zero-value
+// conditions are ignored; Or without any conditions is always false.
+func Or(conds ...Condition) Condition {
+	return join(" OR ", alwaysFalse, conds)
+}
+
+// Not returns the negation of c.
+func Not(c Condition) Condition {
+	if c.build == nil {
+		return c
+	}
+	return Condition{build: func(b *builder) {
+		b.writeString("NOT (")
+		c.build(b)
+		b.writeString(")")
+	}}
+}
+
+func join(sep string, empty Condition, conds []Condition) Condition {
+	parts := make([]Condition, 0, len(conds))
+	for _, c := range conds {
+		if c.build != nil {
+			parts = append(parts, c)
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return empty
+	case 1:
+		return parts[0]
+	}
+	return Condition{compound: true, build: func(b *builder) {
+		for i, c := range parts {
+			if i > 0 {
+				b.writeString(sep)
+			}
+			b.writeCondition(c)
+		}
+	}}
+}
+
+func render(e expr, d Dialect) (string, []interface{}, error) {
+	if e == nil {
+		return "", nil, nil
+	}
+	b := builder{dialect: d}
+	e(&b)
+	return b.sql.String(), d.Placeholder.bind(b.args), b.err
+}
+
+func (c Condition) render() (string, []interface{}, error) {
+	return render(c.build, GoPG)
+}
+
+// Render returns the condition rendered with placeholders of style p along
+// with its arguments in order. It panics if the condition has an error.
+func (c Condition) Render(p Placeholder) (string, []interface{}) {
+	return mustRender(c.build, Dialect{Placeholder: p})
+}
+
+// mustRender is render for the methods that have no error to return, which
+// panic instead so that a condition with an error never reaches the
+// database. Err and ToSQL report the error without panicking.
+func mustRender(e expr, d Dialect) (string, []interface{}) {
+	sql, args, err := render(e, d)
+	if err != nil {
+		panic(err)
+	}
+	return sql, args
+}
+
+// SQL returns the condition rendered with ? placeholders. It panics if the
+// condition has an error, which should be checked with Err first when the
+// condition is built from untrusted input.
+func (c Condition) SQL() string {
+	sql, _ := mustRender(c.build, GoPG)
+	return sql
+}
+
+// Args returns the arguments bound to the placeholders of SQL, in order. It
+// panics if the condition has an error.
+func (c Condition) Args() []interface{} {
+	_, args := mustRender(c.build, GoPG)
+	return args
+}
+
+// Err returns the first error found in the condition or any of the
+// conditions it is made of, such as a raw fragment whose placeholders do not
+// match its arguments.
+func (c Condition) Err() error {
+	_, _, err := c.render()
+	return err
+}
+
+// Tuple returns the condition as the (string, interface{}) pair the field
+// methods used to return, so existing call sites can keep destructuring it.
+// It panics if the condition has an error or binds more than one argument,
+// which the pair cannot hold; such conditions are passed on using SQL and
+// Args instead.
+func (c Condition) Tuple() (string, interface{}) {
+	sql, args := mustRender(c.build, GoPG)
+	switch len(args) {
+	case 0:
+		return sql, nil
+	case 1:
+		return sql, args[0]
+	}
+	panic(fmt.Sprintf("qm: %q binds %d arguments, which Tuple cannot return", sql, len(args)))
+}
+
+// String returns the condition rendered with ? placeholders, even if it has
+// an error, for display.
+func (c Condition) String() string {
+	sql, _, _ := c.render()
+	return sql
+}
@@ -0,0 +1,126 @@
+package qm
+
+import (
+	"reflect"
+	"testing"
+)
+
+// conditionTest is a condition along with the SQL and arguments it is
+// expected to render with ? placeholders.
+type conditionTest struct {
+	name string
+	cond Condition
+	sql  string
+	args []interface{}
+}
+
+func runConditionTests(t *testing.T, tests []conditionTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args := tt.cond.SQL(), tt.cond.Args()
+			if sql != tt.sql {
+				t.Errorf("sql = %q, want %q", sql, tt.sql)
+			}
+			if len(args) != 0 || len(tt.args) != 0 {
+				if !reflect.DeepEqual(args, tt.args) {
+					t.Errorf("args = %#v, want %#v", args, tt.args)
+				}
+			}
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	a := Cond("a", OpEquals, 1)
+	b := Cond("b", OpGreater, 2)
+	c := Cond("c", OpLess, 3)
+
+	runConditionTests(t, []conditionTest{
+		{"single", a, "a = ?", []interface{}{1}},
+		{"and", And(a, b), "a = ? AND b > ?", []interface{}{1, 2}},
+		{"or", Or(a, b), "a = ? OR b > ?", []interface{}{1, 2}},
+		{"and of or", And(Or(a, b), c), "(a = ? OR b > ?) AND c < ?", []interface{}{1, 2, 3}},
+		{"or of and", Or(a, And(b, c)), "a = ? OR (b > ? AND c < ?)", []interface{}{1, 2, 3}},
+		{"not", Not(a), "NOT (a = ?)", []interface{}{1}},
+		{"not of and", Not(And(a, b)), "NOT (a = ? AND b > ?)", []interface{}{1, 2}},
+		{"nested", And(Not(Or(a, b)), Or(c, a)), "NOT (a = ? OR b > ?) AND (c < ? OR a = ?)", []interface{}{1, 2, 3, 1}},
+		{"empty and", And(), "1 = 1", nil},
+		{"empty or", Or(), "1 = 0", nil},
+		{"zero values ignored", And(Condition{}, a, Condition{}), "a = ?", []interface{}{1}},
+		{"single part not parenthesized", Or(And(a)), "a = ?", []interface{}{1}},
+		{"not of zero value", And(Not(Condition{}), a), "a = ?", []interface{}{1}},
+	})
+}
+
+func TestConditionTuple(t *testing.T) {
+	tests := []struct {
+		name string
+		cond Condition
+		sql  string
+		arg  interface{}
+	}{
+		{"one argument", Cond("a", OpEquals, 1), "a = ?", 1},
+		{"no argument", And(), "1 = 1", nil},
+		{"without alias", CondWithoutAlias("u.a", OpEquals, "x"), "a = ?", "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, arg := tt.cond.Tuple()
+			if sql != tt.sql || arg != tt.arg {
+				t.Errorf("Tuple() = %q, %#v, want %q, %#v", sql, arg, tt.sql, tt.arg)
+			}
+		})
+	}
+}
+
+func TestConditionTupleSeveralArguments(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Tuple of a condition binding two arguments did not panic")
+		}
+	}()
+	And(Cond("a", OpEquals, 1), Cond("b", OpEquals, 2)).Tuple()
+}
+
+func TestSqlizeValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		sqlize  func(col string, op Operand, value interface{}) (string, interface{})
+		col     string
+		wantSQL string
+	}{
+		{"with alias", SqlizeValue, "u.age", "u.age = ?"},
+		{"without alias", SqlizeValueWithoutAlias, "u.age", "age = ?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, arg := tt.sqlize(tt.col, OpEquals, 5)
+			if sql != tt.wantSQL || arg != 5 {
+				t.Errorf("got %q, %#v, want %q, 5", sql, arg, tt.wantSQL)
+			}
+		})
+	}
+}
+
+func TestSqlizeValueArity(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      Operand
+		value   interface{}
+		wantSQL string
+		wantArg interface{}
+	}{
+		{"unary binds nothing", OpIsNull, 5, "id IS NULL", nil},
+		{"list", OpIN, []int{1, 2}, "id IN (?, ?)", []int{1, 2}},
+		{"list of one", OpNotIN, []string{"a"}, "id NOT IN (?)", []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, arg := SqlizeValue("id", tt.op, tt.value)
+			if sql != tt.wantSQL || !reflect.DeepEqual(arg, tt.wantArg) {
+				t.Errorf("got %q, %#v, want %q, %#v", sql, arg, tt.wantSQL, tt.wantArg)
+			}
+		})
+	}
+}
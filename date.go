@@ -0,0 +1,375 @@
+package qm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// Date is a calendar date with no time of day or time zone, bound as a
+// YYYY-MM-DD string so that comparisons with date columns never depend on
+// the time zone of the database session.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the calendar date of t in its own location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// AddDays returns the date n days after d, or before it if n is negative.
+func (d Date) AddDays(n int) Date {
+	return DateOf(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+func (d Date) Weekday() time.Weekday {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC).Weekday()
+}
+
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// FirstDayOfWeek is the day weeks start on for InWeekOf.
+var FirstDayOfWeek = time.Monday
+
+// today returns the current date in loc, UTC when loc is nil.
+func today(loc *time.Location) Date {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return DateOf(now().In(loc))
+}
+
+// CondToday returns a condition checking that col holds the current date in
+// the time zone loc.
+func CondToday(col string, loc *time.Location) Condition {
+	d := today(loc)
+	return CondRange(col, d, d.AddDays(1))
+}
+
+// CondWeekOf returns a condition checking that col lies in the week, starting
+// on FirstDayOfWeek, that contains t in the time zone loc.
+func CondWeekOf(col string, t time.Time, loc *time.Location) Condition {
+	if loc == nil {
+		loc = time.UTC
+	}
+	d := DateOf(t.In(loc))
+	start := d.AddDays(-((int(d.Weekday()) - int(FirstDayOfWeek) + 7) % 7))
+	return CondRange(col, start, start.AddDays(7))
+}
+
+// CondMonth returns a condition checking that col lies in the given month.
+func CondMonth(col string, year int, month time.Month) Condition {
+	start := Date{Year: year, Month: month, Day: 1}
+	return CondRange(col, start, DateOf(time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+// CondLastCalendarDays returns a condition checking that col lies within the
+// n calendar days ending with the current date in the time zone loc, today
+// included.
+func CondLastCalendarDays(col string, n int, loc *time.Location) Condition {
+	d := today(loc)
+	return CondRange(col, d.AddDays(1-n), d.AddDays(1))
+}
+
+// DateField is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value. DateField and
+// NullDateField forward to OrderedField, being defined types so that they can
+// have calendar predicates of their own.
+
+type DateField string
+
+type NullDateField string
+
+func (f DateField) ToValue(v Date) Condition {
+	return OrderedField[Date](f).ToValue(v)
+}
+
+func (f NullDateField) ToNullValue(v *Date) Condition {
+	return NullOrderedField[Date](f).ToNullValue(v)
+}
+
+func (f DateField) Equals(v Date) Condition {
+	return OrderedField[Date](f).Equals(v)
+}
+func (f NullDateField) Equals(v *Date) Condition {
+	return NullOrderedField[Date](f).Equals(v)
+}
+
+func (f DateField) GreaterThan(v Date) Condition {
+	return OrderedField[Date](f).GreaterThan(v)
+}
+func (f NullDateField) GreaterThan(v *Date) Condition {
+	return NullOrderedField[Date](f).GreaterThan(v)
+}
+
+func (f DateField) GreaterEqual(v Date) Condition {
+	return OrderedField[Date](f).GreaterEqual(v)
+}
+func (f NullDateField) GreaterEqual(v *Date) Condition {
+	return NullOrderedField[Date](f).GreaterEqual(v)
+}
+
+func (f DateField) In(vs ...Date) Condition {
+	return OrderedField[Date](f).In(vs...)
+}
+func (f NullDateField) In(vs ...*Date) Condition {
+	return NullOrderedField[Date](f).In(vs...)
+}
+
+func (f DateField) IsNotNull() Condition {
+	return OrderedField[Date](f).IsNotNull()
+}
+func (f NullDateField) IsNotNull() Condition {
+	return NullOrderedField[Date](f).IsNotNull()
+}
+func (f NullDateField) IsNull() Condition {
+	return NullOrderedField[Date](f).IsNull()
+}
+
+func (f DateField) LessThan(v Date) Condition {
+	return OrderedField[Date](f).LessThan(v)
+}
+func (f NullDateField) LessThan(v *Date) Condition {
+	return NullOrderedField[Date](f).LessThan(v)
+}
+
+func (f DateField) LessOrEqual(v Date) Condition {
+	return OrderedField[Date](f).LessOrEqual(v)
+}
+func (f NullDateField) LessOrEqual(v *Date) Condition {
+	return NullOrderedField[Date](f).LessOrEqual(v)
+}
+
+func (f DateField) NotEquals(v Date) Condition {
+	return OrderedField[Date](f).NotEquals(v)
+}
+func (f NullDateField) NotEquals(v *Date) Condition {
+	return NullOrderedField[Date](f).NotEquals(v)
+}
+
+func (f NullDateField) IsDistinctFrom(v *Date) Condition {
+	return NullOrderedField[Date](f).IsDistinctFrom(v)
+}
+func (f NullDateField) IsNotDistinctFrom(v *Date) Condition {
+	return NullOrderedField[Date](f).IsNotDistinctFrom(v)
+}
+
+func (f DateField) EqualsValuer(v driver.Valuer) Condition {
+	return OrderedField[Date](f).EqualsValuer(v)
+}
+func (f NullDateField) EqualsValuer(v driver.Valuer) Condition {
+	return NullOrderedField[Date](f).EqualsValuer(v)
+}
+
+func (f DateField) NotEqualsValuer(v driver.Valuer) Condition {
+	return OrderedField[Date](f).NotEqualsValuer(v)
+}
+func (f NullDateField) NotEqualsValuer(v driver.Valuer) Condition {
+	return NullOrderedField[Date](f).NotEqualsValuer(v)
+}
+
+func (f DateField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return OrderedField[Date](f).CompareValuer(op, v)
+}
+func (f NullDateField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return NullOrderedField[Date](f).CompareValuer(op, v)
+}
+
+func (f NullDateField) EqualsNull(v driver.Valuer) Condition {
+	return NullOrderedField[Date](f).EqualsNull(v)
+}
+func (f NullDateField) NotEqualsNull(v driver.Valuer) Condition {
+	return NullOrderedField[Date](f).NotEqualsNull(v)
+}
+
+func (f DateField) NotIn(vs ...Date) Condition {
+	return OrderedField[Date](f).NotIn(vs...)
+}
+func (f NullDateField) NotIn(vs ...*Date) Condition {
+	return NullOrderedField[Date](f).NotIn(vs...)
+}
+
+func (f DateField) InValuers(vs ...driver.Valuer) Condition {
+	return OrderedField[Date](f).InValuers(vs...)
+}
+func (f NullDateField) InValuers(vs ...driver.Valuer) Condition {
+	return NullOrderedField[Date](f).InValuers(vs...)
+}
+
+func (f DateField) NotInValuers(vs ...driver.Valuer) Condition {
+	return OrderedField[Date](f).NotInValuers(vs...)
+}
+func (f NullDateField) NotInValuers(vs ...driver.Valuer) Condition {
+	return NullOrderedField[Date](f).NotInValuers(vs...)
+}
+
+func (f DateField) Between(lo, hi Date) Condition {
+	return OrderedField[Date](f).Between(lo, hi)
+}
+func (f NullDateField) Between(lo, hi *Date) Condition {
+	return NullOrderedField[Date](f).Between(lo, hi)
+}
+
+func (f DateField) NotBetween(lo, hi Date) Condition {
+	return OrderedField[Date](f).NotBetween(lo, hi)
+}
+func (f NullDateField) NotBetween(lo, hi *Date) Condition {
+	return NullOrderedField[Date](f).NotBetween(lo, hi)
+}
+
+func (f DateField) InRange(lo, hi Date) Condition {
+	return OrderedField[Date](f).InRange(lo, hi)
+}
+func (f NullDateField) InRange(lo, hi *Date) Condition {
+	return NullOrderedField[Date](f).InRange(lo, hi)
+}
+
+func (f DateField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return OrderedField[Date](f).BetweenValuers(lo, hi)
+}
+func (f NullDateField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return NullOrderedField[Date](f).BetweenValuers(lo, hi)
+}
+
+func (f DateField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return OrderedField[Date](f).NotBetweenValuers(lo, hi)
+}
+func (f NullDateField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return NullOrderedField[Date](f).NotBetweenValuers(lo, hi)
+}
+
+func (f DateField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return OrderedField[Date](f).InRangeValuers(lo, hi)
+}
+func (f NullDateField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return NullOrderedField[Date](f).InRangeValuers(lo, hi)
+}
+
+func (f DateField) InQuery(sub Subquery) Condition {
+	return OrderedField[Date](f).InQuery(sub)
+}
+func (f NullDateField) InQuery(sub Subquery) Condition {
+	return NullOrderedField[Date](f).InQuery(sub)
+}
+
+func (f DateField) NotInQuery(sub Subquery) Condition {
+	return OrderedField[Date](f).NotInQuery(sub)
+}
+func (f NullDateField) NotInQuery(sub Subquery) Condition {
+	return NullOrderedField[Date](f).NotInQuery(sub)
+}
+
+func (f DateField) CompareTo(op Operand, q Quantified) Condition {
+	return OrderedField[Date](f).CompareTo(op, q)
+}
+func (f NullDateField) CompareTo(op Operand, q Quantified) Condition {
+	return NullOrderedField[Date](f).CompareTo(op, q)
+}
+
+func (f DateField) IsToday(loc *time.Location) Condition {
+	return CondToday(string(f), loc)
+}
+func (f NullDateField) IsToday(loc *time.Location) Condition {
+	return CondToday(string(f), loc)
+}
+
+func (f DateField) InWeekOf(t time.Time, loc *time.Location) Condition {
+	return CondWeekOf(string(f), t, loc)
+}
+func (f NullDateField) InWeekOf(t time.Time, loc *time.Location) Condition {
+	return CondWeekOf(string(f), t, loc)
+}
+
+func (f DateField) InMonth(year int, month time.Month) Condition {
+	return CondMonth(string(f), year, month)
+}
+func (f NullDateField) InMonth(year int, month time.Month) Condition {
+	return CondMonth(string(f), year, month)
+}
+
+func (f DateField) InLastCalendarDays(n int, loc *time.Location) Condition {
+	return CondLastCalendarDays(string(f), n, loc)
+}
+func (f NullDateField) InLastCalendarDays(n int, loc *time.Location) Condition {
+	return CondLastCalendarDays(string(f), n, loc)
+}
+
+func (f DateField) EqualsField(other Column[Date]) Condition {
+	return OrderedField[Date](f).EqualsField(other)
+}
+func (f NullDateField) EqualsField(other Column[Date]) Condition {
+	return NullOrderedField[Date](f).EqualsField(other)
+}
+
+func (f DateField) NotEqualsField(other Column[Date]) Condition {
+	return OrderedField[Date](f).NotEqualsField(other)
+}
+func (f NullDateField) NotEqualsField(other Column[Date]) Condition {
+	return NullOrderedField[Date](f).NotEqualsField(other)
+}
+
+func (f DateField) GreaterThanField(other Column[Date]) Condition {
+	return OrderedField[Date](f).GreaterThanField(other)
+}
+func (f NullDateField) GreaterThanField(other Column[Date]) Condition {
+	return NullOrderedField[Date](f).GreaterThanField(other)
+}
+
+func (f DateField) GreaterEqualField(other Column[Date]) Condition {
+	return OrderedField[Date](f).GreaterEqualField(other)
+}
+func (f NullDateField) GreaterEqualField(other Column[Date]) Condition {
+	return NullOrderedField[Date](f).GreaterEqualField(other)
+}
+
+func (f DateField) LessThanField(other Column[Date]) Condition {
+	return OrderedField[Date](f).LessThanField(other)
+}
+func (f NullDateField) LessThanField(other Column[Date]) Condition {
+	return NullOrderedField[Date](f).LessThanField(other)
+}
+
+func (f DateField) LessOrEqualField(other Column[Date]) Condition {
+	return OrderedField[Date](f).LessOrEqualField(other)
+}
+func (f NullDateField) LessOrEqualField(other Column[Date]) Condition {
+	return NullOrderedField[Date](f).LessOrEqualField(other)
+}
+
+func (f NullDateField) IsDistinctFromField(other Column[Date]) Condition {
+	return NullOrderedField[Date](f).IsDistinctFromField(other)
+}
+func (f NullDateField) IsNotDistinctFromField(other Column[Date]) Condition {
+	return NullOrderedField[Date](f).IsNotDistinctFromField(other)
+}
+
+func (f DateField) Ref() ColumnRef {
+	return OrderedField[Date](f).Ref()
+}
+func (f NullDateField) Ref() ColumnRef {
+	return NullOrderedField[Date](f).Ref()
+}
+
+func (f DateField) WithAlias(alias string) DateField {
+	return DateField(OrderedField[Date](f).WithAlias(alias))
+}
+func (f NullDateField) WithAlias(alias string) NullDateField {
+	return NullDateField(NullOrderedField[Date](f).WithAlias(alias))
+}
+
+func (f DateField) buildColumn(b *builder, _ *Date) {
+	OrderedField[Date](f).buildColumn(b, nil)
+}
+func (f NullDateField) buildColumn(b *builder, _ *Date) {
+	NullOrderedField[Date](f).buildColumn(b, nil)
+}
@@ -0,0 +1,57 @@
+package qm
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Date
+		want Date
+	}{
+		{"of", DateOf(time.Date(2024, 2, 29, 23, 59, 0, 0, time.UTC)), Date{2024, time.February, 29}},
+		{"add day", Date{2024, time.February, 28}.AddDays(1), Date{2024, time.February, 29}},
+		{"add across year", Date{2023, time.December, 31}.AddDays(1), Date{2024, time.January, 1}},
+		{"subtract", Date{2024, time.March, 1}.AddDays(-1), Date{2024, time.February, 29}},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if s := (Date{2024, time.March, 5}).String(); s != "2024-03-05" {
+		t.Errorf("String() = %q", s)
+	}
+	if v, _ := (Date{2024, time.March, 5}).Value(); v != "2024-03-05" {
+		t.Errorf("Value() = %v", v)
+	}
+}
+
+func TestCalendarRanges(t *testing.T) {
+	// 2024-05-01 is a Wednesday, and already May 2 in UTC+5.
+	setNow(t, time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC))
+	plus5 := time.FixedZone("UTC+5", 5*3600)
+	day := DateField("day")
+	d := func(y int, m time.Month, dd int) Date { return Date{y, m, dd} }
+	runConditionTests(t, []conditionTest{
+		{"today", day.IsToday(nil), "day >= ? AND day < ?", []interface{}{d(2024, 5, 1), d(2024, 5, 2)}},
+		{"today east", day.IsToday(plus5), "day >= ? AND day < ?", []interface{}{d(2024, 5, 2), d(2024, 5, 3)}},
+		{"week of", day.InWeekOf(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), nil), "day >= ? AND day < ?", []interface{}{d(2024, 4, 29), d(2024, 5, 6)}},
+		{"week of sunday", day.InWeekOf(time.Date(2024, 5, 5, 12, 0, 0, 0, time.UTC), nil), "day >= ? AND day < ?", []interface{}{d(2024, 4, 29), d(2024, 5, 6)}},
+		{"week of east", day.InWeekOf(time.Date(2024, 5, 5, 20, 0, 0, 0, time.UTC), plus5), "day >= ? AND day < ?", []interface{}{d(2024, 5, 6), d(2024, 5, 13)}},
+		{"month", day.InMonth(2024, time.December), "day >= ? AND day < ?", []interface{}{d(2024, 12, 1), d(2025, 1, 1)}},
+		{"last days", NullDateField("day").InLastCalendarDays(7, nil), "day >= ? AND day < ?", []interface{}{d(2024, 4, 25), d(2024, 5, 2)}},
+		{"equals", day.Equals(d(2024, 1, 2)), "day = ?", []interface{}{d(2024, 1, 2)}},
+	})
+}
+
+func TestFirstDayOfWeek(t *testing.T) {
+	defer func() { FirstDayOfWeek = time.Monday }()
+	FirstDayOfWeek = time.Sunday
+	runConditionTests(t, []conditionTest{
+		{"sunday start", CondWeekOf("day", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), nil), "day >= ? AND day < ?",
+			[]interface{}{Date{2024, time.April, 28}, Date{2024, time.May, 5}}},
+	})
+}
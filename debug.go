@@ -0,0 +1,109 @@
+package qm
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Debug returns the condition with every argument inlined as an SQL literal,
+// so that it can be pasted into psql while investigating a query. It is meant
+// for logging only: the output must never be executed, as escaping done on the
+// Go side is no substitute for bind parameters.
+func (c Condition) Debug() string {
+	return debug(c.build)
+}
+
+// Debug returns the ordering with its arguments inlined, for logging only.
+func (o Ordering) Debug() string {
+	return debug(o.build)
+}
+
+func debug(e expr) string {
+	if e == nil {
+		return ""
+	}
+	b := builder{dialect: GoPG, inline: true}
+	e(&b)
+	return b.sql.String()
+}
+
+// literal formats v as a PostgreSQL literal.
+func literal(v interface{}) string {
+	if valuer, ok := v.(driver.Valuer); ok {
+		if isNilValue(v) {
+			return "NULL"
+		}
+		value, err := valuer.Value()
+		if err != nil {
+			return fmt.Sprintf("NULL /* %s */", strings.Replace(err.Error(), "*/", "* /", -1))
+		}
+		return literal(value)
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quoteLiteral(v)
+	case []byte:
+		if v == nil {
+			return "NULL"
+		}
+		return `'\x` + hex.EncodeToString(v) + "'"
+	case time.Time:
+		return quoteLiteral(v.Format("2006-01-02 15:04:05.999999999-07:00"))
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case float32:
+		return floatLiteral(float64(v), 32)
+	case float64:
+		return floatLiteral(v, 64)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return "NULL"
+		}
+		return literal(rv.Elem().Interface())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.String:
+		return quoteLiteral(rv.String())
+	case reflect.Bool:
+		return literal(rv.Bool())
+	case reflect.Float32:
+		return floatLiteral(rv.Float(), 32)
+	case reflect.Float64:
+		return floatLiteral(rv.Float(), 64)
+	}
+	return quoteLiteral(fmt.Sprint(v))
+}
+
+func floatLiteral(f float64, bitSize int) string {
+	switch {
+	case math.IsNaN(f):
+		return "'NaN'"
+	case math.IsInf(f, 1):
+		return "'Infinity'"
+	case math.IsInf(f, -1):
+		return "'-Infinity'"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bitSize)
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
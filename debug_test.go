@@ -0,0 +1,70 @@
+package qm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("boom */ DROP")
+}
+
+func TestLiteral(t *testing.T) {
+	s := "x"
+	var nilPtr *int
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{"nil", nil, "NULL"},
+		{"string", "it's", "'it''s'"},
+		{"backslash", `a\b`, `'a\b'`},
+		{"bytes", []byte{0xde, 0xad}, `'\xdead'`},
+		{"nil bytes", []byte(nil), "NULL"},
+		{"time", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "'2024-01-02 03:04:05+00:00'"},
+		{"true", true, "TRUE"},
+		{"false", false, "FALSE"},
+		{"int", -42, "-42"},
+		{"uint8", uint8(7), "7"},
+		{"float", 1.5, "1.5"},
+		{"float32", float32(0.1), "0.1"},
+		{"nan", math.NaN(), "'NaN'"},
+		{"infinity", math.Inf(1), "'Infinity'"},
+		{"negative infinity", math.Inf(-1), "'-Infinity'"},
+		{"pointer", &s, "'x'"},
+		{"nil pointer", nilPtr, "NULL"},
+		{"valuer", sql.NullInt64{Int64: 3, Valid: true}, "3"},
+		{"null valuer", sql.NullString{}, "NULL"},
+		{"failing valuer", failingValuer{}, "NULL /* boom * / DROP */"},
+		{"named string", Operand("x'"), "'x'''"},
+	}
+	for _, tt := range tests {
+		if got := literal(tt.v); got != tt.want {
+			t.Errorf("%s: literal(%#v) = %q, want %q", tt.name, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestDebug(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Condition
+		want string
+	}{
+		{"values", And(StringField("name").Equals("o'hara"), IntField("age").In(1, 2)), "name = 'o''hara' AND age IN (1, 2)"},
+		{"raw", Raw("data ?? ? AND a = ?", "k", nil), "data ? 'k' AND a = NULL"},
+		{"zero value", Condition{}, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.c.Debug(); got != tt.want {
+			t.Errorf("%s: Debug() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
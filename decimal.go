@@ -0,0 +1,466 @@
+package qm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+// DecimalError is returned for a value that is not an exact decimal, such as
+// a non-numeric string or a *big.Rat with no finite decimal expansion.
+type DecimalError struct {
+	Value interface{}
+}
+
+func (e *DecimalError) Error() string {
+	if s, ok := e.Value.(fmt.Stringer); ok && !isNilValue(s) {
+		return fmt.Sprintf("qm: invalid decimal %s", s)
+	}
+	return fmt.Sprintf("qm: invalid decimal %#v", e.Value)
+}
+
+var decimalPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?$`)
+
+var (
+	bigTwo  = big.NewInt(2)
+	bigFive = big.NewInt(5)
+)
+
+// ratString returns the decimal expansion of r, if it is finite, that is if
+// the denominator of r has no prime factors other than 2 and 5.
+func ratString(r *big.Rat) (string, bool) {
+	d := new(big.Int).Set(r.Denom())
+	var q, m big.Int
+	twos, fives := 0, 0
+	for q.DivMod(d, bigTwo, &m); m.Sign() == 0; q.DivMod(d, bigTwo, &m) {
+		d.Set(&q)
+		twos++
+	}
+	for q.DivMod(d, bigFive, &m); m.Sign() == 0; q.DivMod(d, bigFive, &m) {
+		d.Set(&q)
+		fives++
+	}
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return "", false
+	}
+	if fives > twos {
+		twos = fives
+	}
+	return r.FloatString(twos), true
+}
+
+// Decimal is an exact decimal number, as bound to numeric columns by
+// DecimalField. Decimals are made from strings with ParseDecimal and from the
+// math/big types with DecimalFromRat and DecimalFromFloat, so that no
+// precision is lost on the way; the zero value is 0.
+type Decimal struct {
+	s string
+}
+
+// ParseDecimal parses s, a decimal number such as "19.99", "-.5" or "1e3".
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalPattern.MatchString(s) {
+		return Decimal{}, &DecimalError{Value: s}
+	}
+	return Decimal{s: s}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is not a decimal
+// number.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFromRat returns r as a decimal. It fails if r has no finite
+// decimal expansion, as 1/3.
+func DecimalFromRat(r *big.Rat) (Decimal, error) {
+	if r != nil {
+		if s, ok := ratString(r); ok {
+			return Decimal{s: s}, nil
+		}
+	}
+	return Decimal{}, &DecimalError{Value: r}
+}
+
+// DecimalFromFloat returns f as a decimal. It fails if f is infinite.
+func DecimalFromFloat(f *big.Float) (Decimal, error) {
+	if f == nil || f.IsInf() {
+		return Decimal{}, &DecimalError{Value: f}
+	}
+	r, _ := f.Rat(nil)
+	s, _ := ratString(r)
+	return Decimal{s: s}, nil
+}
+
+// DecimalFromInt returns i as a decimal.
+func DecimalFromInt(i int64) Decimal {
+	return Decimal{s: strconv.FormatInt(i, 10)}
+}
+
+// String returns d in the notation it was given in.
+func (d Decimal) String() string {
+	if d.s == "" {
+		return "0"
+	}
+	return d.s
+}
+
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Expr returns an expression binding d cast to numeric, so that decimals can
+// appear in arithmetic and be compared to other expressions:
+// Price.Mul(Quantity).GreaterThanField(MustParseDecimal("99.99").Expr()).
+func (d Decimal) Expr() NumericExpr {
+	return Num(decimalArg(d))
+}
+
+// decimalArg binds d as text cast to the numeric type.
+func decimalArg(d Decimal) interface{} {
+	return castArg{value: d.String(), cast: "numeric"}
+}
+
+// nullDecimalArg is like decimalArg but keeps nil as it is, so that nullable
+// fields can compare against NULL.
+func nullDecimalArg(d *Decimal) interface{} {
+	if d == nil {
+		return nil
+	}
+	return decimalArg(*d)
+}
+
+func decimalArgs(ds []Decimal) []interface{} {
+	args := make([]interface{}, len(ds))
+	for i, d := range ds {
+		args[i] = decimalArg(d)
+	}
+	return args
+}
+
+func nullDecimalArgs(ds []*Decimal) []interface{} {
+	args := make([]interface{}, len(ds))
+	for i, d := range ds {
+		args[i] = nullDecimalArg(d)
+	}
+	return args
+}
+
+// decimalValuer binds v, a driver.Valuer such as sql.NullString, cast to the
+// numeric type, its value being taken as is.
+func decimalValuer(v driver.Valuer) interface{} {
+	return castArg{value: v, cast: "numeric"}
+}
+
+func decimalValuers(vs []driver.Valuer) []interface{} {
+	args := make([]interface{}, len(vs))
+	for i, v := range vs {
+		args[i] = decimalValuer(v)
+	}
+	return args
+}
+
+// DecimalField is a component that returns a WhereClause that contains a
+// comparison based on its numeric field and an exact decimal. Values are
+// given as Decimal and bound as text cast to numeric, so that no precision is
+// lost.
+type DecimalField string
+
+type NullDecimalField string
+
+func (f DecimalField) ToValue(v Decimal) Condition {
+	return CondWithoutAlias(string(f), OpEquals, decimalArg(v))
+}
+
+func (f NullDecimalField) ToNullValue(v *Decimal) Condition {
+	return CondWithoutAlias(string(f), OpEquals, nullDecimalArg(v))
+}
+
+func (f DecimalField) Equals(v Decimal) Condition {
+	return Cond(string(f), OpEquals, decimalArg(v))
+}
+func (f NullDecimalField) Equals(v *Decimal) Condition {
+	return CondNullable(string(f), OpEquals, nullDecimalArg(v))
+}
+
+func (f DecimalField) GreaterThan(v Decimal) Condition {
+	return Cond(string(f), OpGreater, decimalArg(v))
+}
+func (f NullDecimalField) GreaterThan(v *Decimal) Condition {
+	return Cond(string(f), OpGreater, nullDecimalArg(v))
+}
+
+func (f DecimalField) GreaterEqual(v Decimal) Condition {
+	return Cond(string(f), OpGreaterEquals, decimalArg(v))
+}
+func (f NullDecimalField) GreaterEqual(v *Decimal) Condition {
+	return Cond(string(f), OpGreaterEquals, nullDecimalArg(v))
+}
+
+func (f DecimalField) In(vs ...Decimal) Condition {
+	return CondList(string(f), OpIN, decimalArgs(vs))
+}
+func (f NullDecimalField) In(vs ...*Decimal) Condition {
+	return CondList(string(f), OpIN, nullDecimalArgs(vs))
+}
+
+func (f DecimalField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullDecimalField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullDecimalField) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f DecimalField) LessThan(v Decimal) Condition {
+	return Cond(string(f), OpLess, decimalArg(v))
+}
+func (f NullDecimalField) LessThan(v *Decimal) Condition {
+	return Cond(string(f), OpLess, nullDecimalArg(v))
+}
+
+func (f DecimalField) LessOrEqual(v Decimal) Condition {
+	return Cond(string(f), OpLessEquals, decimalArg(v))
+}
+func (f NullDecimalField) LessOrEqual(v *Decimal) Condition {
+	return Cond(string(f), OpLessEquals, nullDecimalArg(v))
+}
+
+func (f DecimalField) NotEquals(v Decimal) Condition {
+	return Cond(string(f), OpNotEquals, decimalArg(v))
+}
+func (f NullDecimalField) NotEquals(v *Decimal) Condition {
+	return CondNullable(string(f), OpNotEquals, nullDecimalArg(v))
+}
+
+func (f NullDecimalField) IsDistinctFrom(v *Decimal) Condition {
+	return Cond(string(f), OpIsDistinctFrom, nullDecimalArg(v))
+}
+func (f NullDecimalField) IsNotDistinctFrom(v *Decimal) Condition {
+	return Cond(string(f), OpIsNotDistinctFrom, nullDecimalArg(v))
+}
+
+func (f DecimalField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, decimalValuer(v))
+}
+func (f NullDecimalField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, decimalValuer(v))
+}
+
+func (f DecimalField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, decimalValuer(v))
+}
+func (f NullDecimalField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, decimalValuer(v))
+}
+
+func (f DecimalField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, decimalValuer(v))
+}
+func (f NullDecimalField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, decimalValuer(v))
+}
+
+func (f NullDecimalField) EqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, decimalValuer(v))
+}
+func (f NullDecimalField) NotEqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, decimalValuer(v))
+}
+
+func (f DecimalField) NotIn(vs ...Decimal) Condition {
+	return CondList(string(f), OpNotIN, decimalArgs(vs))
+}
+func (f NullDecimalField) NotIn(vs ...*Decimal) Condition {
+	return CondList(string(f), OpNotIN, nullDecimalArgs(vs))
+}
+
+func (f DecimalField) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, decimalValuers(vs))
+}
+func (f NullDecimalField) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, decimalValuers(vs))
+}
+
+func (f DecimalField) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, decimalValuers(vs))
+}
+func (f NullDecimalField) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, decimalValuers(vs))
+}
+
+func (f DecimalField) Between(lo, hi Decimal) Condition {
+	return CondBetween(string(f), OpBetween, decimalArg(lo), decimalArg(hi))
+}
+func (f NullDecimalField) Between(lo, hi *Decimal) Condition {
+	return CondBetween(string(f), OpBetween, nullDecimalArg(lo), nullDecimalArg(hi))
+}
+
+func (f DecimalField) NotBetween(lo, hi Decimal) Condition {
+	return CondBetween(string(f), OpNotBetween, decimalArg(lo), decimalArg(hi))
+}
+func (f NullDecimalField) NotBetween(lo, hi *Decimal) Condition {
+	return CondBetween(string(f), OpNotBetween, nullDecimalArg(lo), nullDecimalArg(hi))
+}
+
+func (f DecimalField) InRange(lo, hi Decimal) Condition {
+	return CondRange(string(f), decimalArg(lo), decimalArg(hi))
+}
+func (f NullDecimalField) InRange(lo, hi *Decimal) Condition {
+	return CondRange(string(f), nullDecimalArg(lo), nullDecimalArg(hi))
+}
+
+func (f DecimalField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpBetween, decimalValuer(lo), decimalValuer(hi))
+}
+func (f NullDecimalField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpBetween, decimalValuer(lo), decimalValuer(hi))
+}
+
+func (f DecimalField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpNotBetween, decimalValuer(lo), decimalValuer(hi))
+}
+func (f NullDecimalField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpNotBetween, decimalValuer(lo), decimalValuer(hi))
+}
+
+func (f DecimalField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return CondRange(string(f), decimalValuer(lo), decimalValuer(hi))
+}
+func (f NullDecimalField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return CondRange(string(f), decimalValuer(lo), decimalValuer(hi))
+}
+
+func (f DecimalField) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+func (f NullDecimalField) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+
+func (f DecimalField) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+func (f NullDecimalField) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+
+func (f DecimalField) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+func (f NullDecimalField) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+
+func (f DecimalField) EqualsField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildNumeric)
+}
+func (f NullDecimalField) EqualsField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildNumeric)
+}
+
+func (f DecimalField) NotEqualsField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildNumeric)
+}
+func (f NullDecimalField) NotEqualsField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildNumeric)
+}
+
+func (f DecimalField) GreaterThanField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpGreater, other.buildNumeric)
+}
+func (f NullDecimalField) GreaterThanField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpGreater, other.buildNumeric)
+}
+
+func (f DecimalField) GreaterEqualField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpGreaterEquals, other.buildNumeric)
+}
+func (f NullDecimalField) GreaterEqualField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpGreaterEquals, other.buildNumeric)
+}
+
+func (f DecimalField) LessThanField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpLess, other.buildNumeric)
+}
+func (f NullDecimalField) LessThanField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpLess, other.buildNumeric)
+}
+
+func (f DecimalField) LessOrEqualField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpLessEquals, other.buildNumeric)
+}
+func (f NullDecimalField) LessOrEqualField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpLessEquals, other.buildNumeric)
+}
+
+func (f NullDecimalField) IsDistinctFromField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpIsDistinctFrom, other.buildNumeric)
+}
+func (f NullDecimalField) IsNotDistinctFromField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpIsNotDistinctFrom, other.buildNumeric)
+}
+
+func (f DecimalField) Add(other NumericColumn) NumericExpr {
+	return arithmetic(f, "+", other)
+}
+func (f NullDecimalField) Add(other NumericColumn) NumericExpr {
+	return arithmetic(f, "+", other)
+}
+
+func (f DecimalField) Sub(other NumericColumn) NumericExpr {
+	return arithmetic(f, "-", other)
+}
+func (f NullDecimalField) Sub(other NumericColumn) NumericExpr {
+	return arithmetic(f, "-", other)
+}
+
+func (f DecimalField) Mul(other NumericColumn) NumericExpr {
+	return arithmetic(f, "*", other)
+}
+func (f NullDecimalField) Mul(other NumericColumn) NumericExpr {
+	return arithmetic(f, "*", other)
+}
+
+func (f DecimalField) Div(other NumericColumn) NumericExpr {
+	return arithmetic(f, "/", other)
+}
+func (f NullDecimalField) Div(other NumericColumn) NumericExpr {
+	return arithmetic(f, "/", other)
+}
+
+func (f DecimalField) Mod(other NumericColumn) NumericExpr {
+	return arithmetic(f, "%", other)
+}
+func (f NullDecimalField) Mod(other NumericColumn) NumericExpr {
+	return arithmetic(f, "%", other)
+}
+
+func (f DecimalField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullDecimalField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f DecimalField) WithAlias(alias string) DecimalField {
+	return DecimalField(parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullDecimalField) WithAlias(alias string) NullDecimalField {
+	return NullDecimalField(parseColumn(string(f)).WithTable(alias).String())
+}
+
+func (f DecimalField) buildNumeric(b *builder) {
+	b.writeColumn(string(f))
+}
+func (f NullDecimalField) buildNumeric(b *builder) {
+	b.writeColumn(string(f))
+}
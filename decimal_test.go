@@ -0,0 +1,104 @@
+package qm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestRatString(t *testing.T) {
+	tests := []struct {
+		r    *big.Rat
+		want string
+		ok   bool
+	}{
+		{big.NewRat(1, 2), "0.5", true},
+		{big.NewRat(-1999, 100), "-19.99", true},
+		{big.NewRat(1, 40), "0.025", true},
+		{big.NewRat(3, 1), "3", true},
+		{big.NewRat(1, 3), "", false},
+		{big.NewRat(1, 6), "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ratString(tt.r)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ratString(%s) = %q, %v, want %q, %v", tt.r, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{"19.99", true},
+		{"-1.5e3", true},
+		{".5", true},
+		{"+7", true},
+		{"12,50", false},
+		{"1.2.3", false},
+		{"NaN", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		var derr *DecimalError
+		switch {
+		case tt.valid && (err != nil || d.String() != tt.in):
+			t.Errorf("ParseDecimal(%q) = %s, %v", tt.in, d, err)
+		case !tt.valid && !errors.As(err, &derr):
+			t.Errorf("ParseDecimal(%q) = %s, %v, want a *DecimalError", tt.in, d, err)
+		}
+	}
+	if !panics(func() { MustParseDecimal("x") }) {
+		t.Error("MustParseDecimal did not panic")
+	}
+}
+
+func TestDecimalFromBig(t *testing.T) {
+	tests := []struct {
+		name string
+		d    func() (Decimal, error)
+		want string
+	}{
+		{"rat", func() (Decimal, error) { return DecimalFromRat(big.NewRat(1999, 100)) }, "19.99"},
+		{"float", func() (Decimal, error) { return DecimalFromFloat(big.NewFloat(0.5)) }, "0.5"},
+		{"third", func() (Decimal, error) { return DecimalFromRat(big.NewRat(1, 3)) }, ""},
+		{"nil rat", func() (Decimal, error) { return DecimalFromRat(nil) }, ""},
+		{"infinity", func() (Decimal, error) { return DecimalFromFloat(new(big.Float).SetInf(false)) }, ""},
+	}
+	for _, tt := range tests {
+		d, err := tt.d()
+		var derr *DecimalError
+		switch {
+		case tt.want != "" && (err != nil || d.String() != tt.want):
+			t.Errorf("%s: got %s, %v, want %s", tt.name, d, err, tt.want)
+		case tt.want == "" && !errors.As(err, &derr):
+			t.Errorf("%s: got %s, %v, want a *DecimalError", tt.name, d, err)
+		}
+	}
+	if got := DecimalFromInt(-7).String(); got != "-7" {
+		t.Errorf("DecimalFromInt(-7) = %s", got)
+	}
+	if got := (Decimal{}).String(); got != "0" {
+		t.Errorf("zero Decimal = %s", got)
+	}
+}
+
+func TestDecimalField(t *testing.T) {
+	price := DecimalField("price")
+	one, quarter := DecimalFromInt(1), MustParseDecimal("0.25")
+	runConditionTests(t, []conditionTest{
+		{"equals", price.Equals(MustParseDecimal("19.99")), "price = ?::numeric", []interface{}{"19.99"}},
+		{"greater than", price.GreaterThan(quarter), "price > ?::numeric", []interface{}{"0.25"}},
+		{"in", price.In(one, MustParseDecimal("2.5")), "price IN (?::numeric, ?::numeric)", []interface{}{"1", "2.5"}},
+		{"between", price.Between(one, quarter), "price BETWEEN ?::numeric AND ?::numeric", []interface{}{"1", "0.25"}},
+		{"null in range", NullDecimalField("price").InRange(&one, nil), "price >= ?::numeric", []interface{}{"1"}},
+		{"null", NullDecimalField("price").Equals(nil), "price IS NULL", nil},
+		{"null in", NullDecimalField("price").In(&one, nil), "price IN (?::numeric) OR price IS NULL", []interface{}{"1"}},
+		{"arithmetic", price.Mul(IntField("qty")).GreaterThanField(MustParseDecimal("99.99").Expr()), "price * qty > ?::numeric", []interface{}{"99.99"}},
+		{"field", price.LessThanField(NullDecimalField("o.price")), "price < o.price", nil},
+		{"json path", JSONField("data").Path("price").Numeric().LessThan(&quarter), "(data->>'price')::numeric < ?::numeric", []interface{}{"0.25"}},
+	})
+}
@@ -0,0 +1,66 @@
+package qm
+
+// Dialect describes how conditions are rendered for a particular database
+// driver.
+type Dialect struct {
+	// Placeholder is the bind parameter syntax of the driver.
+	Placeholder Placeholder
+	// Quoting is how identifiers are quoted when they must be: reserved
+	// words and identifiers declared quoted, which are requoted with it.
+	// Other identifiers and expressions are written as declared.
+	Quoting Quoting
+	// EscapeQuestionMarks makes literal question marks, such as the ?? escape
+	// of Raw, be written as \? so that the driver does not take them for
+	// placeholders. Only go-pg supports that escape.
+	EscapeQuestionMarks bool
+}
+
+var (
+	// GoPG renders conditions for go-pg, as SQL and Args do. Field names are
+	// written as declared.
+	GoPG = Dialect{Placeholder: Question, EscapeQuestionMarks: true}
+	// Postgres renders conditions for database/sql with pgx or lib/pq.
+	Postgres = Dialect{Placeholder: Dollar, Quoting: DoubleQuotes}
+	// MySQL renders conditions for database/sql with go-sql-driver/mysql.
+	MySQL = Dialect{Placeholder: Question, Quoting: Backticks}
+	// SQLite renders conditions for database/sql with the SQLite drivers.
+	SQLite = Dialect{Placeholder: Question, Quoting: DoubleQuotes}
+	// SQLServer renders conditions for database/sql with go-mssqldb.
+	SQLServer = Dialect{Placeholder: AtP, Quoting: Brackets}
+	// Oracle renders conditions for database/sql with godror. Field names
+	// are written as declared since Oracle folds unquoted names to upper
+	// case.
+	Oracle = Dialect{Placeholder: Colon}
+)
+
+// Unquoted returns d with identifier quoting turned off, rendering field
+// names as declared.
+func (d Dialect) Unquoted() Dialect {
+	d.Quoting = NoQuoting
+	return d
+}
+
+// Sqlizer is implemented by the values qm renders to SQL, so that they can be
+// used with any driver through database/sql.
+type Sqlizer interface {
+	// ToSQL returns the SQL fragment rendered for d, its arguments in
+	// placeholder order, and the first error found while building it.
+	ToSQL(d Dialect) (string, []interface{}, error)
+}
+
+// ToSQL returns the condition rendered for d, ready to follow the WHERE
+// keyword of a query executed through database/sql, along with its
+// arguments in order. The error is that reported by Err.
+func (c Condition) ToSQL(d Dialect) (string, []interface{}, error) {
+	return render(c.build, d)
+}
+
+// ToSQL returns the ordering rendered for d, ready to follow ORDER BY.
+func (o Ordering) ToSQL(d Dialect) (string, []interface{}, error) {
+	return render(o.build, d)
+}
+
+// ToSQL returns the parenthesized subquery rendered for d.
+func (s Subquery) ToSQL(d Dialect) (string, []interface{}, error) {
+	return render(s.build, d)
+}
@@ -0,0 +1,55 @@
+package qm
+
+import "testing"
+
+func TestDialects(t *testing.T) {
+	c := And(StringField("u.name").Equals("x"), Raw("data ?? 'k'"), IntField("age").In(1, 2))
+	tests := []struct {
+		name string
+		d    Dialect
+		sql  string
+	}{
+		{"go-pg", GoPG, `u.name = ? AND (data \? 'k') AND age IN (?, ?)`},
+		{"postgres", Postgres, `u.name = $1 AND (data ? 'k') AND age IN ($2, $3)`},
+		{"mysql", MySQL, "u.name = ? AND (data ? 'k') AND age IN (?, ?)"},
+		{"sqlite", SQLite, `u.name = ? AND (data ? 'k') AND age IN (?, ?)`},
+		{"sql server", SQLServer, "u.name = @p1 AND (data ? 'k') AND age IN (@p2, @p3)"},
+		{"oracle", Oracle, "u.name = :p1 AND (data ? 'k') AND age IN (:p2, :p3)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := c.ToSQL(tt.d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sql != tt.sql {
+				t.Errorf("sql = %q, want %q", sql, tt.sql)
+			}
+			if len(args) != 3 {
+				t.Errorf("args = %v, want 3", args)
+			}
+		})
+	}
+}
+
+func TestQuestionMarkOperands(t *testing.T) {
+	c := Cond("data", OpHasKey, "k")
+	tests := []struct {
+		name string
+		d    Dialect
+		sql  string
+	}{
+		{"go-pg", GoPG, `data \? ?`},
+		{"mysql", MySQL.Unquoted(), "data ? ?"},
+		{"sqlite", SQLite.Unquoted(), "data ? ?"},
+		{"postgres", Postgres.Unquoted(), "data ? $1"},
+	}
+	for _, tt := range tests {
+		if sql, _, _ := c.ToSQL(tt.d); sql != tt.sql {
+			t.Errorf("%s: sql = %q, want %q", tt.name, sql, tt.sql)
+		}
+	}
+	if sql, _ := c.Render(Question); sql != "data ? ?" {
+		t.Errorf("Render(Question) = %q", sql)
+	}
+}
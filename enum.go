@@ -0,0 +1,164 @@
+package qm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Enum is the set of labels of an enumerated type, such as a Go string type
+// whose values are declared as constants and stored in a PostgreSQL enum
+// column. Enum fields, usually generated by cmd/qm-enum, bind their values
+// through an Enum so that values outside of the set, such as conversions of
+// arbitrary strings to the Go type, are reported as an *EnumError by the
+// condition's Err instead of silently matching nothing.
+type Enum struct {
+	name   string
+	labels []string
+	valid  map[string]bool
+}
+
+// NewEnum returns the enum named name, the name of its Go type, made of
+// labels in order.
+func NewEnum(name string, labels ...string) *Enum {
+	e := &Enum{name: name, labels: labels, valid: make(map[string]bool, len(labels))}
+	for _, l := range labels {
+		e.valid[l] = true
+	}
+	return e
+}
+
+// Name returns the name of the Go type of the enum.
+func (e *Enum) Name() string {
+	return e.name
+}
+
+// Labels returns the labels of the enum in declaration order.
+func (e *Enum) Labels() []string {
+	return append([]string(nil), e.labels...)
+}
+
+// EnumError is reported by a condition given a value that is not a label of
+// its enum.
+type EnumError struct {
+	Enum  string
+	Value interface{}
+}
+
+func (e *EnumError) Error() string {
+	return fmt.Sprintf("qm: invalid %s value %#v", e.Enum, e.Value)
+}
+
+// normalize converts v, a value of any string type, a pointer to one or a
+// driver.Valuer, to the plain string label it holds.
+func (e *Enum) normalize(v interface{}) (interface{}, error) {
+	value, null, err := valuerValue(v)
+	if null || err != nil {
+		return nil, err
+	}
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.String || !e.valid[rv.String()] {
+		return nil, &EnumError{Enum: e.name, Value: v}
+	}
+	return rv.String(), nil
+}
+
+// Arg binds v as a label of the enum. Values outside of the enum are
+// reported as an *EnumError by the condition's Err, or make Arg panic when
+// Strict is set.
+func (e *Enum) Arg(v interface{}) interface{} {
+	return newCastArg(v, "", e.normalize)
+}
+
+// NullArg is like Arg but keeps nil values as they are, so that nullable
+// fields can compare against NULL.
+func (e *Enum) NullArg(v interface{}) interface{} {
+	if isNilValue(v) {
+		return nil
+	}
+	return e.Arg(v)
+}
+
+// Args binds the elements of the slice vs with NullArg, for use with
+// CondList.
+func (e *Enum) Args(vs interface{}) []interface{} {
+	rv := reflect.ValueOf(vs)
+	args := make([]interface{}, rv.Len())
+	for i := range args {
+		args[i] = e.NullArg(rv.Index(i).Interface())
+	}
+	return args
+}
+
+// EnumQueryer is the subset of *sql.DB, *sql.Conn and *sql.Tx used by
+// Enum.Check.
+type EnumQueryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// EnumMismatchError is returned by Enum.Check when the labels of the
+// database enum differ from those of the Go enum.
+type EnumMismatchError struct {
+	Enum string
+	Type string
+	// Missing holds the labels of the Go enum the database type lacks, and
+	// Unknown the labels of the database type the Go enum lacks.
+	Missing []string
+	Unknown []string
+}
+
+func (e *EnumMismatchError) Error() string {
+	return fmt.Sprintf("qm: labels of %s do not match enum type %s: missing %q, unknown %q",
+		e.Enum, e.Type, e.Missing, e.Unknown)
+}
+
+// Check compares the labels of the enum to those of the PostgreSQL enum
+// type typ, as listed in pg_enum, and returns an *EnumMismatchError if they
+// differ. It is meant to be run once at startup or from a test.
+//
+// typ is resolved as by a ::regtype cast, so it may be qualified with its
+// schema, as in "billing.status", and is otherwise looked up through the
+// search_path; a type that does not exist is reported by the database.
+func (e *Enum) Check(ctx context.Context, db EnumQueryer, typ string) error {
+	rows, err := db.QueryContext(ctx, `SELECT e.enumlabel FROM pg_enum e
+WHERE e.enumtypid = $1::regtype
+ORDER BY e.enumsortorder`, typ)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	found := make(map[string]bool)
+	for rows.Next() {
+		var label string
+		if err := rows.Scan(&label); err != nil {
+			return err
+		}
+		found[label] = true
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	mismatch := &EnumMismatchError{Enum: e.name, Type: typ}
+	for _, l := range e.labels {
+		if !found[l] {
+			mismatch.Missing = append(mismatch.Missing, l)
+		}
+	}
+	for l := range found {
+		if !e.valid[l] {
+			mismatch.Unknown = append(mismatch.Unknown, l)
+		}
+	}
+	if len(mismatch.Missing) == 0 && len(mismatch.Unknown) == 0 {
+		return nil
+	}
+	sort.Strings(mismatch.Unknown)
+	return mismatch
+}
@@ -0,0 +1,161 @@
+package qm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type status string
+
+var statusEnum = NewEnum("status", "active", "disabled")
+
+func TestEnumArg(t *testing.T) {
+	active := status("active")
+	tests := []struct {
+		name string
+		in   interface{}
+		want interface{}
+	}{
+		{"string", "active", "active"},
+		{"string type", status("disabled"), "disabled"},
+		{"pointer", &active, "active"},
+		{"valuer", sql.NullString{String: "active", Valid: true}, "active"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Cond("s", OpEquals, statusEnum.Arg(tt.in))
+			_, args, err := c.ToSQL(GoPG)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(args) != 1 || args[0] != tt.want {
+				t.Errorf("args = %#v, want %#v", args, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvalidEnum(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Condition
+		args []interface{}
+	}{
+		{"unknown label", Cond("s", OpEquals, statusEnum.Arg(status("gone"))), []interface{}{status("gone")}},
+		{"not a string", Cond("s", OpEquals, statusEnum.Arg(1)), []interface{}{1}},
+		{"in", CondList("s", OpIN, statusEnum.Args([]status{"active", "gone"})), []interface{}{"active", status("gone")}},
+		{"valuer", Cond("s", OpEquals, statusEnum.Arg(sql.NullString{String: "gone", Valid: true})), []interface{}{sql.NullString{String: "gone", Valid: true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, args, err := tt.c.ToSQL(GoPG)
+			var eerr *EnumError
+			if !errors.As(err, &eerr) || eerr.Enum != "status" {
+				t.Fatalf("err = %v, want a *EnumError", err)
+			}
+			// The invalid value is never replaced by NULL.
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestInvalidEnumStrict(t *testing.T) {
+	Strict = true
+	defer func() { Strict = false }()
+	if !panics(func() { statusEnum.Arg("gone") }) {
+		t.Error("Arg did not panic")
+	}
+	if panics(func() { statusEnum.Arg("active") }) {
+		t.Error("Arg panicked on a valid label")
+	}
+}
+
+// enumDriver is a database/sql driver serving the labels of pg_enum, the
+// query and arguments it is given being recorded.
+type enumDriver struct {
+	labels []string
+	query  string
+	args   []driver.NamedValue
+}
+
+func (d *enumDriver) Open(string) (driver.Conn, error) { return d, nil }
+func (d *enumDriver) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+func (d *enumDriver) Close() error              { return nil }
+func (d *enumDriver) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+func (d *enumDriver) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	d.query, d.args = query, args
+	return &enumRows{labels: d.labels}, nil
+}
+
+type enumRows struct {
+	labels []string
+}
+
+func (r *enumRows) Columns() []string { return []string{"enumlabel"} }
+func (r *enumRows) Close() error      { return nil }
+
+func (r *enumRows) Next(dest []driver.Value) error {
+	if len(r.labels) == 0 {
+		return io.EOF
+	}
+	dest[0], r.labels = r.labels[0], r.labels[1:]
+	return nil
+}
+
+type enumConnector struct {
+	d *enumDriver
+}
+
+func (c enumConnector) Connect(context.Context) (driver.Conn, error) { return c.d, nil }
+func (c enumConnector) Driver() driver.Driver                        { return c.d }
+
+func TestEnumCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  []string
+		missing []string
+		unknown []string
+	}{
+		{"match", []string{"active", "disabled"}, nil, nil},
+		{"other order", []string{"disabled", "active"}, nil, nil},
+		{"missing", []string{"active"}, []string{"disabled"}, nil},
+		{"unknown", []string{"active", "disabled", "z", "b"}, nil, []string{"b", "z"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &enumDriver{labels: tt.labels}
+			db := sql.OpenDB(enumConnector{d})
+			defer db.Close()
+
+			err := statusEnum.Check(context.Background(), db, "billing.status")
+			if !strings.Contains(d.query, "$1::regtype") {
+				t.Errorf("query %q does not resolve the type with ::regtype", d.query)
+			}
+			if len(d.args) != 1 || d.args[0].Value != "billing.status" {
+				t.Errorf("args = %#v, want the type name", d.args)
+			}
+			var mismatch *EnumMismatchError
+			switch {
+			case tt.missing == nil && tt.unknown == nil:
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			case !errors.As(err, &mismatch):
+				t.Errorf("err = %v, want an *EnumMismatchError", err)
+			case !reflect.DeepEqual(mismatch.Missing, tt.missing) || !reflect.DeepEqual(mismatch.Unknown, tt.unknown):
+				t.Errorf("missing %q, unknown %q, want %q, %q", mismatch.Missing, mismatch.Unknown, tt.missing, tt.unknown)
+			}
+		})
+	}
+}
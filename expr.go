@@ -0,0 +1,177 @@
+package qm
+
+// NumericExpr is an arithmetic expression over numeric columns and
+// parameters, such as price * quantity. It supports the comparisons of a
+// numeric field and can itself be an operand of further arithmetic, nested
+// expressions being parenthesized as needed.
+type NumericExpr struct {
+	build    expr
+	compound bool
+}
+
+// Num returns an expression binding v as a parameter, so that parameters can
+// appear on either side of arithmetic: Num(100).Sub(Discount).
+func Num(v interface{}) NumericExpr {
+	return NumericExpr{build: func(b *builder) {
+		b.writeArg(v)
+	}}
+}
+
+func arithmetic(lhs NumericColumn, op string, rhs NumericColumn) NumericExpr {
+	return NumericExpr{compound: true, build: func(b *builder) {
+		writeArithmeticOperand(b, lhs)
+		b.writeString(" " + op + " ")
+		writeArithmeticOperand(b, rhs)
+	}}
+}
+
+func writeArithmeticOperand(b *builder, c NumericColumn) {
+	if e, ok := c.(NumericExpr); ok && e.compound {
+		b.writeString("(")
+		e.build(b)
+		b.writeString(")")
+		return
+	}
+	c.buildNumeric(b)
+}
+
+func (e NumericExpr) buildNumeric(b *builder) {
+	e.build(b)
+}
+
+func (e NumericExpr) Add(other NumericColumn) NumericExpr {
+	return arithmetic(e, "+", other)
+}
+
+func (e NumericExpr) Sub(other NumericColumn) NumericExpr {
+	return arithmetic(e, "-", other)
+}
+
+func (e NumericExpr) Mul(other NumericColumn) NumericExpr {
+	return arithmetic(e, "*", other)
+}
+
+func (e NumericExpr) Div(other NumericColumn) NumericExpr {
+	return arithmetic(e, "/", other)
+}
+
+func (e NumericExpr) Mod(other NumericColumn) NumericExpr {
+	return arithmetic(e, "%", other)
+}
+
+func (e NumericExpr) Equals(v interface{}) Condition {
+	return compare(e.build, OpEquals, v)
+}
+
+func (e NumericExpr) NotEquals(v interface{}) Condition {
+	return compare(e.build, OpNotEquals, v)
+}
+
+func (e NumericExpr) GreaterThan(v interface{}) Condition {
+	return compare(e.build, OpGreater, v)
+}
+
+func (e NumericExpr) GreaterEqual(v interface{}) Condition {
+	return compare(e.build, OpGreaterEquals, v)
+}
+
+func (e NumericExpr) LessThan(v interface{}) Condition {
+	return compare(e.build, OpLess, v)
+}
+
+func (e NumericExpr) LessOrEqual(v interface{}) Condition {
+	return compare(e.build, OpLessEquals, v)
+}
+
+func (e NumericExpr) In(vs ...interface{}) Condition {
+	return compareList(e.build, OpIN, vs)
+}
+
+func (e NumericExpr) NotIn(vs ...interface{}) Condition {
+	return compareList(e.build, OpNotIN, vs)
+}
+
+func (e NumericExpr) IsNull() Condition {
+	return compare(e.build, OpIsNull, nil)
+}
+
+func (e NumericExpr) IsNotNull() Condition {
+	return compare(e.build, OpIsNotNull, nil)
+}
+
+func (e NumericExpr) Between(lo, hi interface{}) Condition {
+	return compareBetween(e.build, OpBetween, lo, hi)
+}
+
+func (e NumericExpr) NotBetween(lo, hi interface{}) Condition {
+	return compareBetween(e.build, OpNotBetween, lo, hi)
+}
+
+func (e NumericExpr) InRange(lo, hi interface{}) Condition {
+	return compareRange(e.build, lo, hi)
+}
+
+func (e NumericExpr) EqualsField(other NumericColumn) Condition {
+	return compareExpr(e.build, OpEquals, other.buildNumeric)
+}
+
+func (e NumericExpr) NotEqualsField(other NumericColumn) Condition {
+	return compareExpr(e.build, OpNotEquals, other.buildNumeric)
+}
+
+func (e NumericExpr) GreaterThanField(other NumericColumn) Condition {
+	return compareExpr(e.build, OpGreater, other.buildNumeric)
+}
+
+func (e NumericExpr) GreaterEqualField(other NumericColumn) Condition {
+	return compareExpr(e.build, OpGreaterEquals, other.buildNumeric)
+}
+
+func (e NumericExpr) LessThanField(other NumericColumn) Condition {
+	return compareExpr(e.build, OpLess, other.buildNumeric)
+}
+
+func (e NumericExpr) LessOrEqualField(other NumericColumn) Condition {
+	return compareExpr(e.build, OpLessEquals, other.buildNumeric)
+}
+
+func (e NumericExpr) ASC() Ordering {
+	return ordering(e.build, ASC)
+}
+
+func (e NumericExpr) DESC() Ordering {
+	return ordering(e.build, DESC)
+}
+
+// Ordering is an ORDER BY item over an expression that may bind arguments,
+// to be passed on as OrderExpr(o.SQL(), o.Args()...).
+type Ordering struct {
+	build expr
+}
+
+func ordering(e expr, direction string) Ordering {
+	return Ordering{build: func(b *builder) {
+		e(b)
+		b.writeString(" " + direction)
+	}}
+}
+
+// SQL returns the ordering rendered with ? placeholders. It panics if the
+// ordering has an error.
+func (o Ordering) SQL() string {
+	sql, _ := mustRender(o.build, GoPG)
+	return sql
+}
+
+// Args returns the arguments bound to the placeholders of SQL, in order. It
+// panics if the ordering has an error.
+func (o Ordering) Args() []interface{} {
+	_, args := mustRender(o.build, GoPG)
+	return args
+}
+
+// Render returns the ordering rendered with placeholders of style p along
+// with its arguments in order. It panics if the ordering has an error.
+func (o Ordering) Render(p Placeholder) (string, []interface{}) {
+	return mustRender(o.build, Dialect{Placeholder: p})
+}
@@ -0,0 +1,41 @@
+package qm
+
+import "testing"
+
+func TestArithmetic(t *testing.T) {
+	price, qty := Float64Field("price"), IntField("qty")
+	runConditionTests(t, []conditionTest{
+		{"mul", price.Mul(qty).GreaterThan(100), "price * qty > ?", []interface{}{100}},
+		{"add field", qty.Add(NullIntField("extra")).Equals(3), "qty + extra = ?", []interface{}{3}},
+		{"nested", price.Mul(qty).Sub(Num(5)).LessThan(10), "(price * qty) - ? < ?", []interface{}{5, 10}},
+		{"nested right", Num(100).Sub(price.Mul(qty)).GreaterEqual(0), "? - (price * qty) >= ?", []interface{}{100, 0}},
+		{"div mod", qty.Div(Num(2)).Mod(Num(3)).Equals(1), "(qty / ?) % ? = ?", []interface{}{2, 3, 1}},
+		{"compare field", price.Mul(qty).LessOrEqualField(Float64Field("budget")), "price * qty <= budget", nil},
+		{"compare expr", price.EqualsField(qty.Mul(Num(2))), "price = qty * ?", []interface{}{2}},
+		{"in", qty.Add(Num(1)).In(1, 2), "qty + ? IN (?, ?)", []interface{}{1, 1, 2}},
+		{"between", qty.Sub(Num(1)).Between(0, 5), "qty - ? BETWEEN ? AND ?", []interface{}{1, 0, 5}},
+		{"is null", price.Div(qty).IsNull(), "price / qty IS NULL", nil},
+	})
+}
+
+func TestOrdering(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Ordering
+		sql  string
+		args int
+	}{
+		{"asc", IntField("a").Mul(Num(2)).ASC(), "a * ? ASC", 1},
+		{"desc", Float64Field("price").Mul(IntField("qty")).DESC(), "price * qty DESC", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if sql := tt.o.SQL(); sql != tt.sql {
+				t.Errorf("SQL() = %q, want %q", sql, tt.sql)
+			}
+			if args := tt.o.Args(); len(args) != tt.args {
+				t.Errorf("Args() = %v, want %d args", args, tt.args)
+			}
+		})
+	}
+}
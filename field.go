@@ -0,0 +1,474 @@
+package qm
+
+import "database/sql/driver"
+
+// Number is the constraint of the integer and floating point types NumberField
+// is instantiated with.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Complex is the constraint of the complex types ComplexField is instantiated
+// with.
+type Complex interface {
+	~complex64 | ~complex128
+}
+
+// Text is the constraint of the string types TextField is instantiated with.
+type Text interface {
+	~string
+}
+
+// Column is an SQL expression of values of type T, implemented by Field and
+// OrderedField so that fields of the same type can be compared with one
+// another without binding any parameter. BoolColumn, TimeColumn and
+// DateColumn are its instantiations.
+type Column[T any] interface {
+	buildColumn(b *builder, _ *T)
+}
+
+// columnExpr returns the expression writing the column c.
+func columnExpr[T any](c Column[T]) expr {
+	return func(b *builder) {
+		c.buildColumn(b, nil)
+	}
+}
+
+// Field is a column compared to values of the comparable type T, for which
+// only equality is defined. The typed fields are built on Field and on its
+// ordered, numeric and text counterparts, OrderedField, NumberField and
+// TextField, which are also meant to be instantiated directly with types of
+// their own, such as a string type for the values of a column.
+type Field[T comparable] string
+
+// NullField is the nullable twin of Field. It takes values as pointers, nil
+// standing for NULL.
+type NullField[T comparable] string
+
+func (f Field[T]) ToValue(v T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f NullField[T]) ToNullValue(v *T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f Field[T]) Equals(v T) Condition {
+	return Cond(string(f), OpEquals, v)
+}
+func (f NullField[T]) Equals(v *T) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f Field[T]) In(vs ...T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullField[T]) In(vs ...*T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f Field[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullField[T]) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f Field[T]) NotEquals(v T) Condition {
+	return Cond(string(f), OpNotEquals, v)
+}
+func (f NullField[T]) NotEquals(v *T) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f NullField[T]) IsDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsDistinctFrom, v)
+}
+func (f NullField[T]) IsNotDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsNotDistinctFrom, v)
+}
+
+func (f Field[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f Field[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f Field[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+// EqualsNull compares the field to a database/sql null type, such as
+// sql.NullInt64, which stands for NULL unless Valid is set.
+func (f NullField[T]) EqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullField[T]) NotEqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f Field[T]) NotIn(vs ...T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullField[T]) NotIn(vs ...*T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f Field[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f Field[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f Field[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+func (f NullField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+
+func (f Field[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+func (f NullField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+
+func (f Field[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+func (f NullField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+
+func (f Field[T]) EqualsField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpEquals, columnExpr(other))
+}
+func (f NullField[T]) EqualsField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpEquals, columnExpr(other))
+}
+
+func (f Field[T]) NotEqualsField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, columnExpr(other))
+}
+func (f NullField[T]) NotEqualsField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, columnExpr(other))
+}
+
+func (f NullField[T]) IsDistinctFromField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpIsDistinctFrom, columnExpr(other))
+}
+func (f NullField[T]) IsNotDistinctFromField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpIsNotDistinctFrom, columnExpr(other))
+}
+
+func (f Field[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f Field[T]) WithAlias(alias string) Field[T] {
+	return Field[T](parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullField[T]) WithAlias(alias string) NullField[T] {
+	return NullField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+
+func (f Field[T]) buildColumn(b *builder, _ *T) {
+	b.writeColumn(string(f))
+}
+func (f NullField[T]) buildColumn(b *builder, _ *T) {
+	b.writeColumn(string(f))
+}
+
+// OrderedField is a column compared to values of type T, which is ordered by
+// the SQL type of the column rather than in Go, as booleans, times and dates
+// are.
+type OrderedField[T comparable] string
+
+// NullOrderedField is the nullable twin of OrderedField.
+type NullOrderedField[T comparable] string
+
+func (f OrderedField[T]) ToValue(v T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f NullOrderedField[T]) ToNullValue(v *T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f OrderedField[T]) Equals(v T) Condition {
+	return Cond(string(f), OpEquals, v)
+}
+func (f NullOrderedField[T]) Equals(v *T) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f OrderedField[T]) GreaterThan(v T) Condition {
+	return Cond(string(f), OpGreater, v)
+}
+func (f NullOrderedField[T]) GreaterThan(v *T) Condition {
+	return Cond(string(f), OpGreater, v)
+}
+
+func (f OrderedField[T]) GreaterEqual(v T) Condition {
+	return Cond(string(f), OpGreaterEquals, v)
+}
+func (f NullOrderedField[T]) GreaterEqual(v *T) Condition {
+	return Cond(string(f), OpGreaterEquals, v)
+}
+
+func (f OrderedField[T]) In(vs ...T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullOrderedField[T]) In(vs ...*T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f OrderedField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullOrderedField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullOrderedField[T]) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f OrderedField[T]) LessThan(v T) Condition {
+	return Cond(string(f), OpLess, v)
+}
+func (f NullOrderedField[T]) LessThan(v *T) Condition {
+	return Cond(string(f), OpLess, v)
+}
+
+func (f OrderedField[T]) LessOrEqual(v T) Condition {
+	return Cond(string(f), OpLessEquals, v)
+}
+func (f NullOrderedField[T]) LessOrEqual(v *T) Condition {
+	return Cond(string(f), OpLessEquals, v)
+}
+
+func (f OrderedField[T]) NotEquals(v T) Condition {
+	return Cond(string(f), OpNotEquals, v)
+}
+func (f NullOrderedField[T]) NotEquals(v *T) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f NullOrderedField[T]) IsDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsDistinctFrom, v)
+}
+func (f NullOrderedField[T]) IsNotDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsNotDistinctFrom, v)
+}
+
+func (f OrderedField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullOrderedField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f OrderedField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullOrderedField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f OrderedField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullOrderedField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+// EqualsNull compares the field to a database/sql null type, such as
+// sql.NullInt64, which stands for NULL unless Valid is set.
+func (f NullOrderedField[T]) EqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullOrderedField[T]) NotEqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f OrderedField[T]) NotIn(vs ...T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullOrderedField[T]) NotIn(vs ...*T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f OrderedField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullOrderedField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f OrderedField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullOrderedField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f OrderedField[T]) Between(lo, hi T) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+func (f NullOrderedField[T]) Between(lo, hi *T) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+
+func (f OrderedField[T]) NotBetween(lo, hi T) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+func (f NullOrderedField[T]) NotBetween(lo, hi *T) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+
+func (f OrderedField[T]) InRange(lo, hi T) Condition {
+	return CondRange(string(f), lo, hi)
+}
+func (f NullOrderedField[T]) InRange(lo, hi *T) Condition {
+	return CondRange(string(f), lo, hi)
+}
+
+func (f OrderedField[T]) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+func (f NullOrderedField[T]) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+
+func (f OrderedField[T]) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+func (f NullOrderedField[T]) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+
+func (f OrderedField[T]) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return CondRange(string(f), lo, hi)
+}
+func (f NullOrderedField[T]) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return CondRange(string(f), lo, hi)
+}
+
+func (f OrderedField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+func (f NullOrderedField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+
+func (f OrderedField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+func (f NullOrderedField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+
+func (f OrderedField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+func (f NullOrderedField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+
+func (f OrderedField[T]) EqualsField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpEquals, columnExpr(other))
+}
+func (f NullOrderedField[T]) EqualsField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpEquals, columnExpr(other))
+}
+
+func (f OrderedField[T]) NotEqualsField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, columnExpr(other))
+}
+func (f NullOrderedField[T]) NotEqualsField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, columnExpr(other))
+}
+
+func (f OrderedField[T]) GreaterThanField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpGreater, columnExpr(other))
+}
+func (f NullOrderedField[T]) GreaterThanField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpGreater, columnExpr(other))
+}
+
+func (f OrderedField[T]) GreaterEqualField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpGreaterEquals, columnExpr(other))
+}
+func (f NullOrderedField[T]) GreaterEqualField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpGreaterEquals, columnExpr(other))
+}
+
+func (f OrderedField[T]) LessThanField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpLess, columnExpr(other))
+}
+func (f NullOrderedField[T]) LessThanField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpLess, columnExpr(other))
+}
+
+func (f OrderedField[T]) LessOrEqualField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpLessEquals, columnExpr(other))
+}
+func (f NullOrderedField[T]) LessOrEqualField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpLessEquals, columnExpr(other))
+}
+
+func (f NullOrderedField[T]) IsDistinctFromField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpIsDistinctFrom, columnExpr(other))
+}
+func (f NullOrderedField[T]) IsNotDistinctFromField(other Column[T]) Condition {
+	return compareExpr(column(string(f)), OpIsNotDistinctFrom, columnExpr(other))
+}
+
+func (f OrderedField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullOrderedField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f OrderedField[T]) WithAlias(alias string) OrderedField[T] {
+	return OrderedField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullOrderedField[T]) WithAlias(alias string) NullOrderedField[T] {
+	return NullOrderedField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+
+func (f OrderedField[T]) buildColumn(b *builder, _ *T) {
+	b.writeColumn(string(f))
+}
+func (f NullOrderedField[T]) buildColumn(b *builder, _ *T) {
+	b.writeColumn(string(f))
+}
@@ -0,0 +1,59 @@
+package qm
+
+import "testing"
+
+type label string
+
+type code int
+
+func TestGenericFields(t *testing.T) {
+	one := code(1)
+	runConditionTests(t, []conditionTest{
+		{"field", Field[code]("c").In(1, 2), "c IN (?, ?)", []interface{}{code(1), code(2)}},
+		{"field column", Field[code]("a").EqualsField(NullField[code]("b")), "a = b", nil},
+		{"null field", NullField[code]("c").Equals(nil), "c IS NULL", nil},
+		{"null field distinct", NullField[code]("c").IsDistinctFrom(&one), "c IS DISTINCT FROM ?", []interface{}{&one}},
+		{"ordered", OrderedField[code]("c").Between(1, 3), "c BETWEEN ? AND ?", []interface{}{code(1), code(3)}},
+		{"ordered column", NullOrderedField[code]("a").LessThanField(OrderedField[code]("b")), "a < b", nil},
+		{"number", NumberField[code]("c").GreaterThan(2), "c > ?", []interface{}{code(2)}},
+		{"number mixed", NumberField[code]("a").EqualsField(Float64Field("b")), "a = b", nil},
+		{"number arithmetic", NumberField[code]("a").Add(IntField("b")).GreaterThan(1), "a + b > ?", []interface{}{1}},
+		{"text", TextField[label]("l").Equals("x"), "l = ?", []interface{}{label("x")}},
+		{"text pattern", NullTextField[label]("l").StartsWith("x"), "l LIKE ? ESCAPE '!'", []interface{}{"x%"}},
+		{"text column", TextField[label]("a").EqualsField(StringField("b")), "a = b", nil},
+		{"bool ordering", BoolField("b").GreaterThan(false), "b > ?", []interface{}{false}},
+		{"complex ordering", Complex64Field("c").LessThan(1), "c < ?", []interface{}{complex64(1)}},
+		{"alias", TextField[label]("l").WithAlias("u").Equals("x"), "u.l = ?", []interface{}{label("x")}},
+	})
+}
+
+func TestDistinctFieldTypes(t *testing.T) {
+	kind := func(f interface{}) string {
+		switch f.(type) {
+		case ByteField:
+			return "byte"
+		case Uint8Field:
+			return "uint8"
+		case RuneField:
+			return "rune"
+		case Int32Field:
+			return "int32"
+		}
+		return ""
+	}
+	for f, want := range map[interface{}]string{
+		ByteField("a").WithAlias("t"): "byte",
+		Uint8Field("a"):               "uint8",
+		RuneField("a"):                "rune",
+		Int32Field("a"):               "int32",
+	} {
+		if got := kind(f); got != want {
+			t.Errorf("kind(%T) = %q, want %q", f, got, want)
+		}
+	}
+	runConditionTests(t, []conditionTest{
+		{"byte", ByteField("a").WithAlias("t").Equals('x'), "t.a = ?", []interface{}{byte('x')}},
+		{"byte column", NullByteField("a").GreaterThanField(RuneField("b")), "a > b", nil},
+		{"rune", NullRuneField("a").In(nil), "a IS NULL", nil},
+	})
+}
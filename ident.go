@@ -0,0 +1,206 @@
+package qm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Quoting is the way a dialect quotes identifiers.
+type Quoting int
+
+const (
+	// NoQuoting writes identifiers as they are declared.
+	NoQuoting Quoting = iota
+	// DoubleQuotes quotes identifiers as "name", as PostgreSQL and SQLite do.
+	DoubleQuotes
+	// Backticks quotes identifiers as `name`, as MySQL does.
+	Backticks
+	// Brackets quotes identifiers as [name], as SQL Server does.
+	Brackets
+)
+
+// quote quotes a single identifier, doubling the closing quote character
+// inside it.
+func (q Quoting) quote(name string) string {
+	switch q {
+	case DoubleQuotes:
+		return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+	case Backticks:
+		return "`" + strings.Replace(name, "`", "``", -1) + "`"
+	case Brackets:
+		return "[" + strings.Replace(name, "]", "]]", -1) + "]"
+	}
+	return name
+}
+
+// QuoteIdent quotes each of parts as an identifier of d and joins them with
+// dots, as in QuoteIdent("public", "users") for "public"."users".
+func (d Dialect) QuoteIdent(parts ...string) string {
+	quoted := make([]string, len(parts))
+	for i, part := range parts {
+		quoted[i] = d.Quoting.quote(part)
+	}
+	return strings.Join(quoted, ".")
+}
+
+// splitIdent splits a dotted identifier path into its parts, quoted parts
+// being kept whole with their quotes. It reports false when name is not made
+// of identifiers only.
+func splitIdent(name string) ([]string, bool) {
+	var parts []string
+	for len(name) > 0 {
+		var part string
+		switch name[0] {
+		case '"', '`', '[':
+			closing := name[0]
+			if closing == '[' {
+				closing = ']'
+			}
+			end := 1
+			for {
+				i := strings.IndexByte(name[end:], closing)
+				if i < 0 {
+					return nil, false
+				}
+				end += i + 1
+				if end < len(name) && name[end] == closing {
+					end++
+					continue
+				}
+				break
+			}
+			part = name[:end]
+		default:
+			end := strings.IndexByte(name, '.')
+			if end < 0 {
+				end = len(name)
+			}
+			part = name[:end]
+			if part != "*" && !isPlainIdent(part) {
+				return nil, false
+			}
+		}
+		parts = append(parts, part)
+		name = name[len(part):]
+		if len(name) > 0 {
+			if name[0] != '.' || len(name) == 1 {
+				return nil, false
+			}
+			name = name[1:]
+		}
+	}
+	return parts, len(parts) > 0
+}
+
+// unquote returns the identifier a quoted part stands for, its quotes
+// removed and doubled closing quotes undoubled.
+func unquote(part string) string {
+	closing := part[0]
+	if closing == '[' {
+		closing = ']'
+	}
+	inner := part[1 : len(part)-1]
+	return strings.Replace(inner, string([]byte{closing, closing}), string(closing), -1)
+}
+
+func isQuoted(part string) bool {
+	switch part[0] {
+	case '"', '`', '[':
+		return true
+	}
+	return false
+}
+
+// isPlainIdent reports whether s is an identifier that needs no quoting to
+// be parsed: a letter or underscore followed by letters, digits, underscores
+// or dollar signs.
+func isPlainIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i > 0 && (c == '$' || c >= '0' && c <= '9'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// reserved holds the words reserved by PostgreSQL, MySQL, SQLite or SQL
+// Server that are likely to be used as column or table names, which must be
+// quoted to be used as identifiers.
+var reserved = make(map[string]bool)
+
+func init() {
+	for _, w := range strings.Fields(`
+		add all alter analyse analyze and any array as asc asymmetric
+		authorization before between binary both by call case cast change check
+		collate collation column concurrently condition constraint create cross
+		current_catalog current_date current_role current_schema current_time
+		current_timestamp current_user database default deferrable delete desc
+		describe distinct div do drop each else end except exists explain false
+		fetch file for force foreign freeze from full function grant group
+		groups having if ignore ilike in index initially inner insert intersect
+		interval into is isnull join key keys lateral leading left like limit
+		localtime localtimestamp lock match mod natural not notnull null offset
+		on only open option or order out outer over overlaps partition percent
+		placing plan primary procedure range rank read references release
+		rename repeat replace require return returning right row rows rule
+		schema select session_user set show similar some symmetric system_user
+		table tablesample then to top trailing trigger true union unique update
+		usage use user using values variadic verbose view when where while
+		window with write xor`) {
+		reserved[w] = true
+	}
+}
+
+// needsQuotes reports whether the plain identifier name must be quoted,
+// being a reserved word.
+func needsQuotes(name string) bool {
+	return reserved[strings.ToLower(name)]
+}
+
+// IdentifierError reports a name that cannot safely be used as an
+// identifier.
+type IdentifierError struct {
+	Name string
+}
+
+func (e *IdentifierError) Error() string {
+	return fmt.Sprintf("qm: invalid identifier %q", e.Name)
+}
+
+// Ident validates each of parts as a plain identifier and joins them with
+// dots. Field names are written into the SQL as declared, expressions
+// included, and are not checked: they are trusted like the rest of the code.
+// Ident is the way to declare fields from dynamic input, such as a sort
+// column picked by a client, which must not be able to inject SQL:
+//
+//	col, err := qm.Ident("u", r.FormValue("sort"))
+//	if err != nil {
+//		return err
+//	}
+//	field := qm.StringField(col)
+func Ident(parts ...string) (string, error) {
+	if len(parts) == 0 {
+		return "", &IdentifierError{}
+	}
+	for _, part := range parts {
+		if !isPlainIdent(part) {
+			return "", &IdentifierError{Name: part}
+		}
+	}
+	return strings.Join(parts, "."), nil
+}
+
+// MustIdent is like Ident but panics if a part is not a valid identifier.
+func MustIdent(parts ...string) string {
+	name, err := Ident(parts...)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
@@ -0,0 +1,111 @@
+package qm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSplitIdent(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+		ok    bool
+	}{
+		{"id", []string{"id"}, true},
+		{"u.id", []string{"u", "id"}, true},
+		{"public.users.id", []string{"public", "users", "id"}, true},
+		{`"My Table".id`, []string{`"My Table"`, "id"}, true},
+		{`"a""b".c`, []string{`"a""b"`, "c"}, true},
+		{"`t`.[c]", []string{"`t`", "[c]"}, true},
+		{"u.*", []string{"u", "*"}, true},
+		{"a.weird name", nil, false},
+		{"x;drop", nil, false},
+		{"a.", nil, false},
+		{`"open`, nil, false},
+		{"", nil, false},
+	}
+	for _, tt := range tests {
+		parts, ok := splitIdent(tt.name)
+		if ok != tt.ok || !reflect.DeepEqual(parts, tt.parts) {
+			t.Errorf("splitIdent(%q) = %q, %v, want %q, %v", tt.name, parts, ok, tt.parts, tt.ok)
+		}
+	}
+}
+
+func TestIdent(t *testing.T) {
+	tests := []struct {
+		parts []string
+		want  string
+		err   bool
+	}{
+		{[]string{"u", "name"}, "u.name", false},
+		{[]string{"created_at"}, "created_at", false},
+		{[]string{"name; DROP TABLE users"}, "", true},
+		{[]string{"u", `"x"`}, "", true},
+		{[]string{"1abc"}, "", true},
+		{nil, "", true},
+	}
+	for _, tt := range tests {
+		got, err := Ident(tt.parts...)
+		var ierr *IdentifierError
+		if got != tt.want || errors.As(err, &ierr) != tt.err {
+			t.Errorf("Ident(%q) = %q, %v", tt.parts, got, err)
+		}
+	}
+	if !panics(func() { MustIdent("a b") }) {
+		t.Error("MustIdent did not panic")
+	}
+}
+
+func TestQuoting(t *testing.T) {
+	tests := []struct {
+		name                                   string
+		postgres, mysql, sqlServer, goPG, orcl string
+	}{
+		{"users.createdAt", "users.createdAt", "users.createdAt", "users.createdAt", "users.createdAt", "users.createdAt"},
+		{"u.order", `u."order"`, "u.`order`", "u.[order]", "u.order", "u.order"},
+		{"public.User.id", `public."User".id`, "public.`User`.id", "public.[User].id", "public.User.id", "public.User.id"},
+		{`"Weird Name"`, `"Weird Name"`, "`Weird Name`", "[Weird Name]", `"Weird Name"`, `"Weird Name"`},
+		{"`a``b`", "\"a`b\"", "`a``b`", "[a`b]", "`a``b`", "`a``b`"},
+		{"u.*", "u.*", "u.*", "u.*", "u.*", "u.*"},
+		{"data->'key'->>'x'", "data->'key'->>'x'", "data->'key'->>'x'", "data->'key'->>'x'", "data->'key'->>'x'", "data->'key'->>'x'"},
+		{"lower(name)", "lower(name)", "lower(name)", "lower(name)", "lower(name)", "lower(name)"},
+	}
+	for _, tt := range tests {
+		for _, d := range []struct {
+			dialect Dialect
+			want    string
+		}{{Postgres, tt.postgres}, {MySQL, tt.mysql}, {SQLServer, tt.sqlServer}, {GoPG, tt.goPG}, {Oracle, tt.orcl}} {
+			sql, _, err := Cond(tt.name, OpIsNull, nil).ToSQL(d.dialect)
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			if want := d.want + " IS NULL"; sql != want {
+				t.Errorf("%s with quoting %d = %q, want %q", tt.name, d.dialect.Quoting, sql, want)
+			}
+		}
+	}
+}
+
+func TestRelations(t *testing.T) {
+	tests := []struct {
+		rels           []string
+		want, postgres string
+	}{
+		{[]string{"u", "name"}, "u.name", "u.name"},
+		{[]string{"public.users", "id"}, "public.users.id", "public.users.id"},
+		{[]string{"u", "order"}, "u.order", `u."order"`},
+		{[]string{"u", `"Mixed"`}, `u."Mixed"`, `u."Mixed"`},
+	}
+	for _, tt := range tests {
+		got := Relations(tt.rels...)
+		if got != tt.want {
+			t.Errorf("Relations(%q) = %q, want %q", tt.rels, got, tt.want)
+		}
+		sql, _, err := Cond(got, OpIsNull, nil).ToSQL(Postgres)
+		if want := tt.postgres + " IS NULL"; sql != want || err != nil {
+			t.Errorf("Relations(%q) renders as %q, %v, want %q", tt.rels, sql, err, want)
+		}
+	}
+}
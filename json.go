@@ -0,0 +1,287 @@
+package qm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONError is reported by a condition given a value that cannot be encoded
+// as JSON.
+type JSONError struct {
+	Value interface{}
+	Err   error
+}
+
+func (e *JSONError) Error() string {
+	return fmt.Sprintf("qm: cannot encode %#v as JSON: %v", e.Value, e.Err)
+}
+
+func (e *JSONError) Unwrap() error {
+	return e.Err
+}
+
+// marshalJSON encodes v with encoding/json. Byte slices and json.RawMessage
+// are taken to hold JSON already, as are the []byte and string values of a
+// driver.Valuer, such as the JSON types of the common driver packages.
+func marshalJSON(v interface{}) (interface{}, error) {
+	value, null, err := valuerValue(v)
+	if null || err != nil {
+		return nil, err
+	}
+	if _, ok := v.(driver.Valuer); ok {
+		if s, ok := value.(string); ok {
+			value = []byte(s)
+		}
+	}
+	switch raw := value.(type) {
+	case json.RawMessage:
+		return validJSON(v, raw)
+	case []byte:
+		return validJSON(v, raw)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, &JSONError{Value: v, Err: err}
+	}
+	return string(b), nil
+}
+
+// validJSON returns raw as a string if it holds valid JSON, v being the
+// value it was given as.
+func validJSON(v interface{}, raw []byte) (interface{}, error) {
+	if !json.Valid(raw) {
+		return nil, &JSONError{Value: v, Err: fmt.Errorf("invalid JSON %q", raw)}
+	}
+	return string(raw), nil
+}
+
+// jsonArg binds v encoded as JSON and cast to jsonb.
+func jsonArg(v interface{}) interface{} {
+	return newCastArg(v, "jsonb", marshalJSON)
+}
+
+// nullJSONArg is like jsonArg but keeps nil values as they are, so that
+// nullable fields can compare against NULL.
+func nullJSONArg(v interface{}) interface{} {
+	if isNilValue(v) {
+		return nil
+	}
+	return jsonArg(v)
+}
+
+// condKeys returns a condition checking col for keys using OpHasAnyKey or
+// OpHasAllKeys, the keys being bound as an ARRAY[...] of text.
+func condKeys(col string, op Operand, keys []string) Condition {
+	return Condition{build: func(b *builder) {
+		b.writeColumn(col)
+		b.writeString(" ")
+		b.writeOperand(op)
+		b.writeString(" ARRAY[")
+		for i, key := range keys {
+			if i > 0 {
+				b.writeString(", ")
+			}
+			b.writeArg(key)
+		}
+		b.writeString("]::text[]")
+	}}
+}
+
+// JSONPath is a path into a jsonb column, from which typed sub-fields are
+// extracted. Extracted values are NULL where the path does not exist, so the
+// sub-fields are nullable ones.
+type JSONPath struct {
+	col   string
+	elems []string
+}
+
+// Path returns the path extended with the given object keys.
+func (p JSONPath) Path(keys ...string) JSONPath {
+	elems := make([]string, 0, len(p.elems)+len(keys))
+	elems = append(elems, p.elems...)
+	for _, key := range keys {
+		elems = append(elems, quoteLiteral(key))
+	}
+	return JSONPath{col: p.col, elems: elems}
+}
+
+// Index returns the path extended with the i-th element of an array, as in
+// data->'tags'->0.
+func (p JSONPath) Index(i int) JSONPath {
+	elems := make([]string, 0, len(p.elems)+1)
+	elems = append(elems, p.elems...)
+	elems = append(elems, strconv.Itoa(i))
+	return JSONPath{col: p.col, elems: elems}
+}
+
+func (p JSONPath) ref(text bool) string {
+	var sb strings.Builder
+	sb.WriteString(p.col)
+	for i, elem := range p.elems {
+		if text && i == len(p.elems)-1 {
+			sb.WriteString("->>")
+		} else {
+			sb.WriteString("->")
+		}
+		sb.WriteString(elem)
+	}
+	return sb.String()
+}
+
+// JSON returns the jsonb value at the path, extracted with ->.
+func (p JSONPath) JSON() NullJSONField {
+	return NullJSONField(p.ref(false))
+}
+
+// Text returns the value at the path as text, extracted with ->>.
+func (p JSONPath) Text() NullStringField {
+	return NullStringField(p.ref(true))
+}
+
+// Numeric returns the value at the path cast to numeric, as an exact decimal.
+func (p JSONPath) Numeric() NullDecimalField {
+	return NullDecimalField("(" + p.ref(true) + ")::numeric")
+}
+
+// Int returns the value at the path cast to bigint.
+func (p JSONPath) Int() NullInt64Field {
+	return NullInt64Field("(" + p.ref(true) + ")::bigint")
+}
+
+// Bool returns the value at the path cast to boolean.
+func (p JSONPath) Bool() NullBoolField {
+	return NullBoolField("(" + p.ref(true) + ")::boolean")
+}
+
+// JSONColumn is an SQL expression of the jsonb type, implemented by JSONField
+// and NullJSONField.
+type JSONColumn interface {
+	buildJSON(b *builder)
+}
+
+// JSONField is a component that returns a WhereClause that contains a
+// comparison based on its jsonb field and a value encoded with
+// encoding/json. Encoding errors are reported as a *JSONError by the
+// condition's Err, or make the method panic when Strict is set.
+type JSONField string
+
+type NullJSONField string
+
+func (f JSONField) ToValue(v interface{}) Condition {
+	return CondWithoutAlias(string(f), OpEquals, jsonArg(v))
+}
+
+func (f NullJSONField) ToNullValue(v interface{}) Condition {
+	return CondWithoutAlias(string(f), OpEquals, nullJSONArg(v))
+}
+
+func (f JSONField) Equals(v interface{}) Condition {
+	return Cond(string(f), OpEquals, jsonArg(v))
+}
+func (f NullJSONField) Equals(v interface{}) Condition {
+	return CondNullable(string(f), OpEquals, nullJSONArg(v))
+}
+
+func (f JSONField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullJSONField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullJSONField) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f JSONField) NotEquals(v interface{}) Condition {
+	return Cond(string(f), OpNotEquals, jsonArg(v))
+}
+func (f NullJSONField) NotEquals(v interface{}) Condition {
+	return CondNullable(string(f), OpNotEquals, nullJSONArg(v))
+}
+
+func (f JSONField) Contains(v interface{}) Condition {
+	return Cond(string(f), OpContains, jsonArg(v))
+}
+func (f NullJSONField) Contains(v interface{}) Condition {
+	return Cond(string(f), OpContains, jsonArg(v))
+}
+
+func (f JSONField) ContainedBy(v interface{}) Condition {
+	return Cond(string(f), OpContainedBy, jsonArg(v))
+}
+func (f NullJSONField) ContainedBy(v interface{}) Condition {
+	return Cond(string(f), OpContainedBy, jsonArg(v))
+}
+
+func (f JSONField) HasKey(key string) Condition {
+	return Cond(string(f), OpHasKey, key)
+}
+func (f NullJSONField) HasKey(key string) Condition {
+	return Cond(string(f), OpHasKey, key)
+}
+
+func (f JSONField) HasAnyKey(keys ...string) Condition {
+	return condKeys(string(f), OpHasAnyKey, keys)
+}
+func (f NullJSONField) HasAnyKey(keys ...string) Condition {
+	return condKeys(string(f), OpHasAnyKey, keys)
+}
+
+func (f JSONField) HasAllKeys(keys ...string) Condition {
+	return condKeys(string(f), OpHasAllKeys, keys)
+}
+func (f NullJSONField) HasAllKeys(keys ...string) Condition {
+	return condKeys(string(f), OpHasAllKeys, keys)
+}
+
+func (f JSONField) Path(keys ...string) JSONPath {
+	return JSONPath{col: string(f)}.Path(keys...)
+}
+func (f NullJSONField) Path(keys ...string) JSONPath {
+	return JSONPath{col: string(f)}.Path(keys...)
+}
+
+func (f JSONField) EqualsField(other JSONColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildJSON)
+}
+func (f NullJSONField) EqualsField(other JSONColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildJSON)
+}
+
+func (f JSONField) ContainsField(other JSONColumn) Condition {
+	return compareExpr(column(string(f)), OpContains, other.buildJSON)
+}
+func (f NullJSONField) ContainsField(other JSONColumn) Condition {
+	return compareExpr(column(string(f)), OpContains, other.buildJSON)
+}
+
+func (f JSONField) ContainedByField(other JSONColumn) Condition {
+	return compareExpr(column(string(f)), OpContainedBy, other.buildJSON)
+}
+func (f NullJSONField) ContainedByField(other JSONColumn) Condition {
+	return compareExpr(column(string(f)), OpContainedBy, other.buildJSON)
+}
+
+func (f JSONField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullJSONField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f JSONField) WithAlias(alias string) JSONField {
+	return JSONField(parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullJSONField) WithAlias(alias string) NullJSONField {
+	return NullJSONField(parseColumn(string(f)).WithTable(alias).String())
+}
+
+func (f JSONField) buildJSON(b *builder) {
+	b.writeColumn(string(f))
+}
+func (f NullJSONField) buildJSON(b *builder) {
+	b.writeColumn(string(f))
+}
@@ -0,0 +1,115 @@
+package qm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// jsonDoc is a JSON document bound through its Value method, as the JSON
+// types of driver packages are.
+type jsonDoc string
+
+func (d jsonDoc) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		in   interface{}
+		want interface{}
+	}{
+		{"map", map[string]int{"a": 1}, `{"a":1}`},
+		{"string", "x", `"x"`},
+		{"number", 1.5, `1.5`},
+		{"nil", nil, `null`},
+		{"bytes", []byte(`{"a": [1, 2]}`), `{"a": [1, 2]}`},
+		{"raw message", json.RawMessage(`[1,2]`), `[1,2]`},
+		{"valuer", jsonDoc(`{"b":true}`), `{"b":true}`},
+		{"null valuer", sql.NullString{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := marshalJSON(tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("marshalJSON(%#v) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONField(t *testing.T) {
+	data := JSONField("data")
+	x, one := "x", int64(1)
+	runConditionTests(t, []conditionTest{
+		{"equals", data.Equals(map[string]int{"a": 1}), "data = ?::jsonb", []interface{}{`{"a":1}`}},
+		{"not equals", data.NotEquals([]int{1}), "data != ?::jsonb", []interface{}{`[1]`}},
+		{"contains", data.Contains(json.RawMessage(`{"a":1}`)), "data @> ?::jsonb", []interface{}{`{"a":1}`}},
+		{"contained by", data.ContainedBy([]byte(`{}`)), "data <@ ?::jsonb", []interface{}{`{}`}},
+		{"has key", data.HasKey("a"), `data \? ?`, []interface{}{"a"}},
+		{"has any key", data.HasAnyKey("a", "b"), `data \?| ARRAY[?, ?]::text[]`, []interface{}{"a", "b"}},
+		{"has all keys", data.HasAllKeys("a"), `data \?& ARRAY[?]::text[]`, []interface{}{"a"}},
+		{"valuer", data.Equals(jsonDoc(`{"b":true}`)), "data = ?::jsonb", []interface{}{`{"b":true}`}},
+		{"null", NullJSONField("data").Equals(nil), "data IS NULL", nil},
+		{"field", data.ContainsField(NullJSONField("o.data")), "data @> o.data", nil},
+		{"path text", data.Path("a", "b").Text().Equals(&x), "data->'a'->>'b' = ?", []interface{}{&x}},
+		{"path json", data.Path("a").JSON().IsNull(), "data->'a' IS NULL", nil},
+		{"path int", data.Path("n").Int().Equals(&one), "(data->>'n')::bigint = ?", []interface{}{&one}},
+		{"path quoted key", data.Path("it's").Text().IsNotNull(), "data->>'it''s' IS NOT NULL", nil},
+		{"path index", data.Path("tags").Index(0).Text().Equals(&x), "data->'tags'->>0 = ?", []interface{}{&x}},
+		{"path after index", data.Path("items").Index(1).Path("id").Int().Equals(&one), "(data->'items'->1->>'id')::bigint = ?", []interface{}{&one}},
+	})
+}
+
+func TestJSONFieldDialects(t *testing.T) {
+	c := JSONField("data").HasAnyKey("a")
+	tests := []struct {
+		dialect Dialect
+		sql     string
+	}{
+		{GoPG, `data \?| ARRAY[?]::text[]`},
+		{Postgres, `data ?| ARRAY[$1]::text[]`},
+	}
+	for _, tt := range tests {
+		sql, _, err := c.ToSQL(tt.dialect)
+		if err != nil || sql != tt.sql {
+			t.Errorf("ToSQL = %q, %v, want %q", sql, err, tt.sql)
+		}
+	}
+}
+
+func TestInvalidJSON(t *testing.T) {
+	data := JSONField("data")
+	tests := []struct {
+		name string
+		c    Condition
+		args []interface{}
+	}{
+		{"bytes", data.Equals([]byte("{")), []interface{}{[]byte("{")}},
+		{"raw message", data.Contains(json.RawMessage("nope")), []interface{}{json.RawMessage("nope")}},
+		{"unsupported", data.Equals(make(chan int)), nil},
+		{"valuer", data.Equals(jsonDoc("{")), []interface{}{jsonDoc("{")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, args, err := tt.c.ToSQL(GoPG)
+			var jerr *JSONError
+			if !errors.As(err, &jerr) {
+				t.Fatalf("err = %v, want a *JSONError", err)
+			}
+			// The invalid value is never replaced by NULL.
+			if len(args) != 1 || args[0] == nil {
+				t.Errorf("args = %#v, want the value as given", args)
+			} else if tt.args != nil && !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
@@ -0,0 +1,511 @@
+package qm
+
+import "database/sql/driver"
+
+// NumberField is a column of integers or floating point numbers compared to
+// values of type T. It can be compared to any other numeric column and used in
+// arithmetic.
+type NumberField[T Number] string
+
+// NullNumberField is the nullable twin of NumberField.
+type NullNumberField[T Number] string
+
+func (f NumberField[T]) ToValue(v T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f NullNumberField[T]) ToNullValue(v *T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f NumberField[T]) Equals(v T) Condition {
+	return Cond(string(f), OpEquals, v)
+}
+func (f NullNumberField[T]) Equals(v *T) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f NumberField[T]) GreaterThan(v T) Condition {
+	return Cond(string(f), OpGreater, v)
+}
+func (f NullNumberField[T]) GreaterThan(v *T) Condition {
+	return Cond(string(f), OpGreater, v)
+}
+
+func (f NumberField[T]) GreaterEqual(v T) Condition {
+	return Cond(string(f), OpGreaterEquals, v)
+}
+func (f NullNumberField[T]) GreaterEqual(v *T) Condition {
+	return Cond(string(f), OpGreaterEquals, v)
+}
+
+func (f NumberField[T]) In(vs ...T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullNumberField[T]) In(vs ...*T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f NumberField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullNumberField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullNumberField[T]) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f NumberField[T]) LessThan(v T) Condition {
+	return Cond(string(f), OpLess, v)
+}
+func (f NullNumberField[T]) LessThan(v *T) Condition {
+	return Cond(string(f), OpLess, v)
+}
+
+func (f NumberField[T]) LessOrEqual(v T) Condition {
+	return Cond(string(f), OpLessEquals, v)
+}
+func (f NullNumberField[T]) LessOrEqual(v *T) Condition {
+	return Cond(string(f), OpLessEquals, v)
+}
+
+func (f NumberField[T]) NotEquals(v T) Condition {
+	return Cond(string(f), OpNotEquals, v)
+}
+func (f NullNumberField[T]) NotEquals(v *T) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f NullNumberField[T]) IsDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsDistinctFrom, v)
+}
+func (f NullNumberField[T]) IsNotDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsNotDistinctFrom, v)
+}
+
+func (f NumberField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullNumberField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f NumberField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullNumberField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f NumberField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullNumberField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+// EqualsNull compares the field to a database/sql null type, such as
+// sql.NullInt64, which stands for NULL unless Valid is set.
+func (f NullNumberField[T]) EqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullNumberField[T]) NotEqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f NumberField[T]) NotIn(vs ...T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullNumberField[T]) NotIn(vs ...*T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f NumberField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullNumberField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f NumberField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullNumberField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f NumberField[T]) Between(lo, hi T) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+func (f NullNumberField[T]) Between(lo, hi *T) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+
+func (f NumberField[T]) NotBetween(lo, hi T) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+func (f NullNumberField[T]) NotBetween(lo, hi *T) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+
+func (f NumberField[T]) InRange(lo, hi T) Condition {
+	return CondRange(string(f), lo, hi)
+}
+func (f NullNumberField[T]) InRange(lo, hi *T) Condition {
+	return CondRange(string(f), lo, hi)
+}
+
+func (f NumberField[T]) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+func (f NullNumberField[T]) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+
+func (f NumberField[T]) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+func (f NullNumberField[T]) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+
+func (f NumberField[T]) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return CondRange(string(f), lo, hi)
+}
+func (f NullNumberField[T]) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return CondRange(string(f), lo, hi)
+}
+
+func (f NumberField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+func (f NullNumberField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+
+func (f NumberField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+func (f NullNumberField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+
+func (f NumberField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+func (f NullNumberField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+
+func (f NumberField[T]) EqualsField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildNumeric)
+}
+func (f NullNumberField[T]) EqualsField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildNumeric)
+}
+
+func (f NumberField[T]) NotEqualsField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildNumeric)
+}
+func (f NullNumberField[T]) NotEqualsField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildNumeric)
+}
+
+func (f NumberField[T]) GreaterThanField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpGreater, other.buildNumeric)
+}
+func (f NullNumberField[T]) GreaterThanField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpGreater, other.buildNumeric)
+}
+
+func (f NumberField[T]) GreaterEqualField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpGreaterEquals, other.buildNumeric)
+}
+func (f NullNumberField[T]) GreaterEqualField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpGreaterEquals, other.buildNumeric)
+}
+
+func (f NumberField[T]) LessThanField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpLess, other.buildNumeric)
+}
+func (f NullNumberField[T]) LessThanField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpLess, other.buildNumeric)
+}
+
+func (f NumberField[T]) LessOrEqualField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpLessEquals, other.buildNumeric)
+}
+func (f NullNumberField[T]) LessOrEqualField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpLessEquals, other.buildNumeric)
+}
+
+func (f NullNumberField[T]) IsDistinctFromField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpIsDistinctFrom, other.buildNumeric)
+}
+func (f NullNumberField[T]) IsNotDistinctFromField(other NumericColumn) Condition {
+	return compareExpr(column(string(f)), OpIsNotDistinctFrom, other.buildNumeric)
+}
+
+func (f NumberField[T]) Add(other NumericColumn) NumericExpr {
+	return arithmetic(f, "+", other)
+}
+func (f NullNumberField[T]) Add(other NumericColumn) NumericExpr {
+	return arithmetic(f, "+", other)
+}
+
+func (f NumberField[T]) Sub(other NumericColumn) NumericExpr {
+	return arithmetic(f, "-", other)
+}
+func (f NullNumberField[T]) Sub(other NumericColumn) NumericExpr {
+	return arithmetic(f, "-", other)
+}
+
+func (f NumberField[T]) Mul(other NumericColumn) NumericExpr {
+	return arithmetic(f, "*", other)
+}
+func (f NullNumberField[T]) Mul(other NumericColumn) NumericExpr {
+	return arithmetic(f, "*", other)
+}
+
+func (f NumberField[T]) Div(other NumericColumn) NumericExpr {
+	return arithmetic(f, "/", other)
+}
+func (f NullNumberField[T]) Div(other NumericColumn) NumericExpr {
+	return arithmetic(f, "/", other)
+}
+
+func (f NumberField[T]) Mod(other NumericColumn) NumericExpr {
+	return arithmetic(f, "%", other)
+}
+func (f NullNumberField[T]) Mod(other NumericColumn) NumericExpr {
+	return arithmetic(f, "%", other)
+}
+
+func (f NumberField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullNumberField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f NumberField[T]) WithAlias(alias string) NumberField[T] {
+	return NumberField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullNumberField[T]) WithAlias(alias string) NullNumberField[T] {
+	return NullNumberField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+
+func (f NumberField[T]) buildNumeric(b *builder) {
+	b.writeColumn(string(f))
+}
+func (f NullNumberField[T]) buildNumeric(b *builder) {
+	b.writeColumn(string(f))
+}
+
+// ComplexField is a column of complex numbers compared to values of type T.
+// Complex columns are only compared with one another for equality.
+type ComplexField[T Complex] string
+
+// NullComplexField is the nullable twin of ComplexField.
+type NullComplexField[T Complex] string
+
+func (f ComplexField[T]) ToValue(v T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f NullComplexField[T]) ToNullValue(v *T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f ComplexField[T]) Equals(v T) Condition {
+	return Cond(string(f), OpEquals, v)
+}
+func (f NullComplexField[T]) Equals(v *T) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+// Deprecated: complex numbers have no order.
+func (f ComplexField[T]) GreaterThan(v T) Condition {
+	return Cond(string(f), OpGreater, v)
+}
+
+// Deprecated: complex numbers have no order.
+func (f NullComplexField[T]) GreaterThan(v *T) Condition {
+	return Cond(string(f), OpGreater, v)
+}
+
+// Deprecated: complex numbers have no order.
+func (f ComplexField[T]) GreaterEqual(v T) Condition {
+	return Cond(string(f), OpGreaterEquals, v)
+}
+
+// Deprecated: complex numbers have no order.
+func (f NullComplexField[T]) GreaterEqual(v *T) Condition {
+	return Cond(string(f), OpGreaterEquals, v)
+}
+
+func (f ComplexField[T]) In(vs ...T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullComplexField[T]) In(vs ...*T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f ComplexField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullComplexField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullComplexField[T]) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+// Deprecated: complex numbers have no order.
+func (f ComplexField[T]) LessThan(v T) Condition {
+	return Cond(string(f), OpLess, v)
+}
+
+// Deprecated: complex numbers have no order.
+func (f NullComplexField[T]) LessThan(v *T) Condition {
+	return Cond(string(f), OpLess, v)
+}
+
+// Deprecated: complex numbers have no order.
+func (f ComplexField[T]) LessOrEqual(v T) Condition {
+	return Cond(string(f), OpLessEquals, v)
+}
+
+// Deprecated: complex numbers have no order.
+func (f NullComplexField[T]) LessOrEqual(v *T) Condition {
+	return Cond(string(f), OpLessEquals, v)
+}
+
+func (f ComplexField[T]) NotEquals(v T) Condition {
+	return Cond(string(f), OpNotEquals, v)
+}
+func (f NullComplexField[T]) NotEquals(v *T) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f NullComplexField[T]) IsDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsDistinctFrom, v)
+}
+func (f NullComplexField[T]) IsNotDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsNotDistinctFrom, v)
+}
+
+func (f ComplexField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullComplexField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f ComplexField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullComplexField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f ComplexField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullComplexField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+// EqualsNull compares the field to a database/sql null type, such as
+// sql.NullInt64, which stands for NULL unless Valid is set.
+func (f NullComplexField[T]) EqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullComplexField[T]) NotEqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f ComplexField[T]) NotIn(vs ...T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullComplexField[T]) NotIn(vs ...*T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f ComplexField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullComplexField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f ComplexField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullComplexField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f ComplexField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+func (f NullComplexField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+
+func (f ComplexField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+func (f NullComplexField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+
+func (f ComplexField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+func (f NullComplexField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+
+func (f ComplexField[T]) EqualsField(other ComplexColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildComplex)
+}
+func (f NullComplexField[T]) EqualsField(other ComplexColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildComplex)
+}
+
+func (f ComplexField[T]) NotEqualsField(other ComplexColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildComplex)
+}
+func (f NullComplexField[T]) NotEqualsField(other ComplexColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildComplex)
+}
+
+func (f NullComplexField[T]) IsDistinctFromField(other ComplexColumn) Condition {
+	return compareExpr(column(string(f)), OpIsDistinctFrom, other.buildComplex)
+}
+func (f NullComplexField[T]) IsNotDistinctFromField(other ComplexColumn) Condition {
+	return compareExpr(column(string(f)), OpIsNotDistinctFrom, other.buildComplex)
+}
+
+func (f ComplexField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullComplexField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f ComplexField[T]) WithAlias(alias string) ComplexField[T] {
+	return ComplexField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullComplexField[T]) WithAlias(alias string) NullComplexField[T] {
+	return NullComplexField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+
+func (f ComplexField[T]) buildComplex(b *builder) {
+	b.writeColumn(string(f))
+}
+func (f NullComplexField[T]) buildComplex(b *builder) {
+	b.writeColumn(string(f))
+}
@@ -0,0 +1,81 @@
+package qm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LikeEscape is the escape character declared in the ESCAPE clause of every
+// LIKE condition built by CondLike. It is not a backslash since MySQL, unlike
+// PostgreSQL and SQLite, treats backslashes in string literals as escapes,
+// so that ESCAPE '\' does not parse there.
+const LikeEscape = "!"
+
+var likeReplacer = strings.NewReplacer(LikeEscape, LikeEscape+LikeEscape, "%", LikeEscape+"%", "_", LikeEscape+"_")
+
+// EscapeLike escapes the LIKE wildcards % and _ as well as the escape
+// character itself, so that s matches literally inside a pattern.
+func EscapeLike(s string) string {
+	return likeReplacer.Replace(s)
+}
+
+// CondLike returns a condition matching col against pattern using a pattern
+// operand such as OpLike or OpILike. The escape character is always declared
+// explicitly, so patterns built with EscapeLike behave the same regardless of
+// the server defaults.
+func CondLike(col string, op Operand, pattern string) Condition {
+	return Condition{build: func(b *builder) {
+		b.writeColumn(col)
+		b.writeString(" ")
+		b.writeOperand(op)
+		b.writeString(" ")
+		b.writeArg(pattern)
+		b.writeString(" ESCAPE '" + LikeEscape + "'")
+	}}
+}
+
+// PatternError is reported by a condition whose regular expression is
+// rejected by CheckRegexp.
+type PatternError struct {
+	Pattern string
+	Err     error
+}
+
+func (e *PatternError) Error() string {
+	return fmt.Sprintf("qm: invalid regular expression %q: %v", e.Pattern, e.Err)
+}
+
+func (e *PatternError) Unwrap() error {
+	return e.Err
+}
+
+// CheckRegexp, when set, is called by CondRegexp with every pattern, an error
+// it returns being reported as a *PatternError from the condition's Err. It
+// is nil by default, patterns being left to the database: PostgreSQL
+// implements Advanced Regular Expressions, which Go's regexp package does not,
+// the latter rejecting back-references, lookahead and the \y, \m and \M word
+// boundaries while accepting syntax PostgreSQL rejects, such as (?P<name>...).
+// Set it when the patterns of an application stick to a subset both accept:
+//
+//	qm.CheckRegexp = func(p string) error {
+//		_, err := regexp.Compile(p)
+//		return err
+//	}
+var CheckRegexp func(pattern string) error
+
+// CondRegexp returns a condition matching col against the regular expression
+// pattern using a regex operand such as OpMatches. The pattern is checked by
+// CheckRegexp when set, and otherwise only by the database.
+func CondRegexp(col string, op Operand, pattern string) Condition {
+	c := Cond(col, op, pattern)
+	if CheckRegexp == nil {
+		return c
+	}
+	if err := CheckRegexp(pattern); err != nil {
+		return Condition{build: func(b *builder) {
+			c.build(b)
+			b.setErr(&PatternError{Pattern: pattern, Err: err})
+		}}
+	}
+	return c
+}
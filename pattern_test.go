@@ -0,0 +1,90 @@
+package qm
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"abc", "abc"},
+		{"50%", "50!%"},
+		{"a_b", "a!_b"},
+		{"wow!", "wow!!"},
+		{`C:\dir`, `C:\dir`},
+		{"!%_", "!!!%!_"},
+	}
+	for _, tt := range tests {
+		if got := EscapeLike(tt.in); got != tt.want {
+			t.Errorf("EscapeLike(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLike(t *testing.T) {
+	name := StringField("name")
+	runConditionTests(t, []conditionTest{
+		{"like", name.Like("a%"), "name LIKE ? ESCAPE '!'", []interface{}{"a%"}},
+		{"not like", name.NotLike("a%"), "name NOT LIKE ? ESCAPE '!'", []interface{}{"a%"}},
+		{"ilike", name.ILike("a%"), "name ILIKE ? ESCAPE '!'", []interface{}{"a%"}},
+		{"starts with", name.StartsWith("50%"), "name LIKE ? ESCAPE '!'", []interface{}{"50!%%"}},
+		{"ends with", name.EndsWith("_x"), "name LIKE ? ESCAPE '!'", []interface{}{"%!_x"}},
+		{"contains", name.Contains("a!b"), "name LIKE ? ESCAPE '!'", []interface{}{"%a!!b%"}},
+		{"icontains", name.IContains("%"), "name ILIKE ? ESCAPE '!'", []interface{}{"%!%%"}},
+		{"nullable", NullStringField("name").IStartsWith("x"), "name ILIKE ? ESCAPE '!'", []interface{}{"x%"}},
+	})
+}
+
+func TestRegexp(t *testing.T) {
+	name := StringField("name")
+	runConditionTests(t, []conditionTest{
+		{"matches", name.Matches("^a"), "name ~ ?", []interface{}{"^a"}},
+		{"matches insensitive", name.MatchesInsensitive("^a"), "name ~* ?", []interface{}{"^a"}},
+		{"not matches", name.NotMatches("^a"), "name !~ ?", []interface{}{"^a"}},
+		{"not matches insensitive", name.NotMatchesInsensitive("^a"), "name !~* ?", []interface{}{"^a"}},
+		{"similar to", name.SimilarTo("%(b|d)%"), "name SIMILAR TO ?", []interface{}{"%(b|d)%"}},
+		{"not similar to", name.NotSimilarTo("%(b|d)%"), "name NOT SIMILAR TO ?", []interface{}{"%(b|d)%"}},
+		{"word boundary", name.Matches(`\ycat\y`), "name ~ ?", []interface{}{`\ycat\y`}},
+		{"word start and end", name.Matches(`\mfoo\M`), "name ~ ?", []interface{}{`\mfoo\M`}},
+		{"back-reference", name.Matches(`(a)\1`), "name ~ ?", []interface{}{`(a)\1`}},
+		{"lookahead", name.Matches(`a(?=b)`), "name ~ ?", []interface{}{`a(?=b)`}},
+	})
+}
+
+func TestCheckRegexp(t *testing.T) {
+	defer func() { CheckRegexp = nil }()
+	CheckRegexp = func(p string) error {
+		_, err := regexp.Compile(p)
+		return err
+	}
+	tests := []struct {
+		pattern string
+		invalid bool
+	}{
+		{"^a+$", false},
+		{"(a", true},
+		{`(a)\1`, true},
+	}
+	for _, tt := range tests {
+		err := CondRegexp("name", OpMatches, tt.pattern).Err()
+		var perr *PatternError
+		if got := errors.As(err, &perr); got != tt.invalid {
+			t.Errorf("CondRegexp(%q).Err() = %v, want invalid %v", tt.pattern, err, tt.invalid)
+		}
+	}
+}
+
+func TestLikeDialects(t *testing.T) {
+	for _, d := range []Dialect{GoPG, Postgres, MySQL, SQLite, SQLServer, Oracle} {
+		sql, _, err := CondLike("name", OpLike, "x").ToSQL(d.Unquoted())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "name LIKE " + d.Placeholder.format(1) + " ESCAPE '!'"; sql != want {
+			t.Errorf("ToSQL(%v) = %q, want %q", d, sql, want)
+		}
+	}
+}
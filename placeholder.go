@@ -0,0 +1,51 @@
+package qm
+
+import (
+	"database/sql"
+	"strconv"
+)
+
+// Placeholder is the bind parameter syntax conditions are rendered with.
+// The same condition can be rendered with any of them, placeholders being
+// numbered across all the conditions, subqueries and raw fragments it is made
+// of.
+type Placeholder int
+
+const (
+	// Question renders ? placeholders, as expected by go-pg and by the MySQL
+	// and SQLite drivers.
+	Question Placeholder = iota
+	// Dollar renders $1, $2, ... placeholders, as expected by pgx and lib/pq.
+	Dollar
+	// AtP renders @p1, @p2, ... placeholders, as expected by SQL Server.
+	AtP
+	// Colon renders :p1, :p2, ... named placeholders, the arguments being
+	// passed as sql.Named values.
+	Colon
+)
+
+// format returns the placeholder of the n-th argument, starting at 1.
+func (p Placeholder) format(n int) string {
+	switch p {
+	case Dollar:
+		return "$" + strconv.Itoa(n)
+	case AtP:
+		return "@p" + strconv.Itoa(n)
+	case Colon:
+		return ":p" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// bind returns the arguments in the form expected along with placeholders
+// of style p.
+func (p Placeholder) bind(args []interface{}) []interface{} {
+	if p != Colon {
+		return args
+	}
+	named := make([]interface{}, len(args))
+	for i, v := range args {
+		named[i] = sql.Named("p"+strconv.Itoa(i+1), v)
+	}
+	return named
+}
@@ -0,0 +1,52 @@
+package qm
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+func TestPlaceholders(t *testing.T) {
+	c := And(
+		IntField("a").Equals(1),
+		Or(StringField("b").In("x", "y"), Raw("c = ? OR d = ?", 2, 3)),
+		IntField("e").InQuery(NewSubquery("SELECT e FROM t WHERE f = ? AND g = ?", 4, 5)),
+		Not(IntField("h").Between(6, 7)),
+	)
+	tests := []struct {
+		p    Placeholder
+		sql  string
+		args []interface{}
+	}{
+		{Question, "a = ? AND (b IN (?, ?) OR (c = ? OR d = ?)) AND e IN (SELECT e FROM t WHERE f = ? AND g = ?) AND NOT (h BETWEEN ? AND ?)",
+			[]interface{}{1, "x", "y", 2, 3, 4, 5, 6, 7}},
+		{Dollar, "a = $1 AND (b IN ($2, $3) OR (c = $4 OR d = $5)) AND e IN (SELECT e FROM t WHERE f = $6 AND g = $7) AND NOT (h BETWEEN $8 AND $9)",
+			[]interface{}{1, "x", "y", 2, 3, 4, 5, 6, 7}},
+		{AtP, "a = @p1 AND (b IN (@p2, @p3) OR (c = @p4 OR d = @p5)) AND e IN (SELECT e FROM t WHERE f = @p6 AND g = @p7) AND NOT (h BETWEEN @p8 AND @p9)",
+			[]interface{}{1, "x", "y", 2, 3, 4, 5, 6, 7}},
+		{Colon, "a = :p1 AND (b IN (:p2, :p3) OR (c = :p4 OR d = :p5)) AND e IN (SELECT e FROM t WHERE f = :p6 AND g = :p7) AND NOT (h BETWEEN :p8 AND :p9)",
+			[]interface{}{
+				sql.Named("p1", 1), sql.Named("p2", "x"), sql.Named("p3", "y"),
+				sql.Named("p4", 2), sql.Named("p5", 3), sql.Named("p6", 4),
+				sql.Named("p7", 5), sql.Named("p8", 6), sql.Named("p9", 7),
+			}},
+	}
+	for _, tt := range tests {
+		gotSQL, gotArgs := c.Render(tt.p)
+		if gotSQL != tt.sql {
+			t.Errorf("Render(%d) sql = %q, want %q", tt.p, gotSQL, tt.sql)
+		}
+		if !reflect.DeepEqual(gotArgs, tt.args) {
+			t.Errorf("Render(%d) args = %#v, want %#v", tt.p, gotArgs, tt.args)
+		}
+	}
+}
+
+func TestPlaceholdersRenderedTwice(t *testing.T) {
+	c := Or(IntField("a").Equals(1), IntField("b").Equals(2))
+	for i := 0; i < 2; i++ {
+		if sql, _ := c.Render(Dollar); sql != "a = $1 OR b = $2" {
+			t.Fatalf("render %d: %q", i, sql)
+		}
+	}
+}
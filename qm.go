@@ -1,6 +1,7 @@
 package qm
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"strings"
 )
@@ -9,20 +10,85 @@ type Operand string
 
 const (
 	OpEquals        Operand = "="
-	OpIN                    = "IN"
-	OpNotIN                 = "NOT IN"
-	OpGreater               = ">"
-	OpGreaterEquals         = ">="
-	OpLess                  = "<"
-	OpLessEquals            = "<="
-	OpNotEquals             = "!="
+	OpIN            Operand = "IN"
+	OpNotIN         Operand = "NOT IN"
+	OpGreater       Operand = ">"
+	OpGreaterEquals Operand = ">="
+	OpLess          Operand = "<"
+	OpLessEquals    Operand = "<="
+	OpNotEquals     Operand = "!="
+
+	OpIsDistinctFrom    Operand = "IS DISTINCT FROM"
+	OpIsNotDistinctFrom Operand = "IS NOT DISTINCT FROM"
+
+	OpBetween    Operand = "BETWEEN"
+	OpNotBetween Operand = "NOT BETWEEN"
+
+	OpLike     Operand = "LIKE"
+	OpNotLike  Operand = "NOT LIKE"
+	OpILike    Operand = "ILIKE"
+	OpNotILike Operand = "NOT ILIKE"
+
+	OpMatches               Operand = "~"
+	OpMatchesInsensitive    Operand = "~*"
+	OpNotMatches            Operand = "!~"
+	OpNotMatchesInsensitive Operand = "!~*"
+	OpSimilarTo             Operand = "SIMILAR TO"
+	OpNotSimilarTo          Operand = "NOT SIMILAR TO"
+
+	OpContains    Operand = "@>"
+	OpContainedBy Operand = "<@"
+	OpHasKey      Operand = "?"
+	OpHasAnyKey   Operand = "?|"
+	OpHasAllKeys  Operand = "?&"
+	OpOverlaps    Operand = "&&"
+
+	OpIsNull Operand = "IS NULL"
+
+	OpIsNotNull Operand = "IS NOT NULL"
+)
 
-	OpIsNull = "IS NULL"
+// Arity tells how many values an Operand takes besides the column.
+type Arity int
 
-	OpIsNotNull = "IS NOT NULL"
+const (
+	// Unary operands take no value: col IS NULL.
+	Unary Arity = iota
+	// Binary operands take a single value: col = ?.
+	Binary
+	// List operands take a parenthesized list of values: col IN (?, ?).
+	List
+	// Ternary operands take a lower and an upper bound: col BETWEEN ? AND ?.
+	Ternary
 )
 
+func (op Operand) Arity() Arity {
+	switch op {
+	case OpIsNull, OpIsNotNull:
+		return Unary
+	case OpIN, OpNotIN:
+		return List
+	case OpBetween, OpNotBetween:
+		return Ternary
+	}
+	return Binary
+}
+
 func Sqlize(col string, op Operand, placeholders ...string) string {
+	switch op.Arity() {
+	case Unary:
+		return fmt.Sprintf("%s %s", col, op)
+	case List:
+		if len(placeholders) == 0 {
+			placeholders = []string{"?"}
+		}
+		return fmt.Sprintf("%s %s (%s)", col, op, strings.Join(placeholders, ", "))
+	case Ternary:
+		if len(placeholders) == 0 {
+			placeholders = []string{"?", "?"}
+		}
+		return fmt.Sprintf("%s %s %s", col, op, strings.Join(placeholders, " AND "))
+	}
 	if len(placeholders) != 0 {
 		return fmt.Sprintf("%s %s %s", col, op, strings.Join(placeholders, ""))
 	}
@@ -30,13 +96,21 @@ func Sqlize(col string, op Operand, placeholders ...string) string {
 }
 
 func SqlizeValueWithoutAlias(col string, op Operand, value interface{}) (string, interface{}) {
-	colValues := strings.Split(col, ".")
-	v := colValues[len(colValues)-1]
-	return fmt.Sprintf("%s %s ?", v, op), value
+	return sqlizeValue(CondWithoutAlias(col, op, value), op, value)
 }
 
+// SqlizeValue renders col op value with ? placeholders along with the value to
+// bind to them: none for unary operands, and for list operands the slice as
+// given, one placeholder being written per element.
 func SqlizeValue(col string, op Operand, value interface{}) (string, interface{}) {
-	return fmt.Sprintf("%s %s ?", col, op), value
+	return sqlizeValue(Cond(col, op, value), op, value)
+}
+
+func sqlizeValue(c Condition, op Operand, value interface{}) (string, interface{}) {
+	if op.Arity() == Unary {
+		return c.SQL(), nil
+	}
+	return c.SQL(), value
 }
 
 const (
@@ -44,6 +118,10 @@ const (
 	DESC        = "DESC"
 )
 
+// Relations joins rels into a dotted field name, as in Relations("u", "name")
+// for u.name. The parts are neither quoted nor checked: reserved words are
+// quoted by the dialect when rendering, and parts from dynamic input go
+// through Ident.
 func Relations(rels ...string) string {
 	return strings.Join(rels,".")
 }
@@ -62,1054 +140,413 @@ func (o OrderBy) String() string {
 	return string(o)
 }
 
-type BoolField string
-
-type NullBoolField string
-
-func (f BoolField) ToValue(v bool) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f NullBoolField) ToNullValue(v *bool) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f BoolField) Equals(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullBoolField) Equals(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-
-func (f BoolField) GreaterThan(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullBoolField) GreaterThan(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
+type BoolField = OrderedField[bool]
 
-func (f BoolField) GreaterEqual(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullBoolField) GreaterEqual(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f BoolField) In(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullBoolField) In(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-
-func (f BoolField) IsNotNull(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullBoolField) IsNotNull(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullBoolField) IsNull(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
-
-func (f BoolField) LessThan(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullBoolField) LessThan(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-
-func (f BoolField) LessOrEqual(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullBoolField) LessOrEqual(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-
-func (f BoolField) NotEquals(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullBoolField) NotEquals(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-
-func (f BoolField) NotIn(v bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
-func (f NullBoolField) NotIn(v *bool) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
+type NullBoolField = NullOrderedField[bool]
 
 // StringField is a component that returns a WhereClause that contains a
 // comparison based on its field and a strongly typed value.
 
-type StringField string
-
-type NullStringField string
-
-func (f StringField) ToValue(v string) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f NullStringField) ToNullValue(v *string) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f StringField) Equals(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullStringField) Equals(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-
-func (f StringField) GreaterThan(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullStringField) GreaterThan(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-
-func (f StringField) GreaterEqual(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullStringField) GreaterEqual(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f StringField) In(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullStringField) In(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-
-func (f StringField) IsNotNull(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullStringField) IsNotNull(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullStringField) IsNull(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
-
-func (f StringField) LessThan(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullStringField) LessThan(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
+type StringField = TextField[string]
 
-func (f StringField) LessOrEqual(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullStringField) LessOrEqual(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-
-func (f StringField) NotEquals(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullStringField) NotEquals(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-
-func (f StringField) NotIn(v string) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
-func (f NullStringField) NotIn(v *string) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
+type NullStringField = NullTextField[string]
 
 // IntField is a component that returns a WhereClause that contains a
 // comparison based on its field and a strongly typed value.
 
-type IntField string
-
-type NullIntField string
-
-func (f IntField) ToValue(v int) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f NullIntField) ToNullValue(v *int) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f IntField) Equals(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullIntField) Equals(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
+type IntField = NumberField[int]
 
-func (f IntField) GreaterThan(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullIntField) GreaterThan(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-
-func (f IntField) GreaterEqual(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullIntField) GreaterEqual(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f IntField) In(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullIntField) In(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-
-func (f IntField) IsNotNull(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullIntField) IsNotNull(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullIntField) IsNull(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
-
-func (f IntField) LessThan(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullIntField) LessThan(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-
-func (f IntField) LessOrEqual(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullIntField) LessOrEqual(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-
-func (f IntField) NotEquals(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullIntField) NotEquals(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-
-func (f IntField) NotIn(v int) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
-func (f NullIntField) NotIn(v *int) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
+type NullIntField = NullNumberField[int]
 
 // Int8Field is a component that returns a WhereClause that contains a
 // comparison based on its field and a strongly typed value.
 
-type Int8Field string
-
-type NullInt8Field string
+type Int8Field = NumberField[int8]
 
-func (f Int8Field) ToValue(v int8) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f NullInt8Field) ToNullValue(v *int8) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f Int8Field) Equals(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullInt8Field) Equals(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-
-func (f Int8Field) GreaterThan(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullInt8Field) GreaterThan(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-
-func (f Int8Field) GreaterEqual(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullInt8Field) GreaterEqual(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f Int8Field) In(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullInt8Field) In(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-
-func (f Int8Field) IsNotNull(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullInt8Field) IsNotNull(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullInt8Field) IsNull(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
-
-func (f Int8Field) LessThan(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullInt8Field) LessThan(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-
-func (f Int8Field) LessOrEqual(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullInt8Field) LessOrEqual(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-
-func (f Int8Field) NotEquals(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullInt8Field) NotEquals(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-
-func (f Int8Field) NotIn(v int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
-func (f NullInt8Field) NotIn(v *int8) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
+type NullInt8Field = NullNumberField[int8]
 
 // Int16Field is a component that returns a WhereClause that contains a
 // comparison based on its field and a strongly typed value.
 
-type Int16Field string
-
-type NullInt16Field string
+type Int16Field = NumberField[int16]
 
-func (f Int16Field) ToValue(v int16) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f NullInt16Field) ToNullValue(v *int16) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f Int16Field) Equals(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullInt16Field) Equals(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-
-func (f Int16Field) GreaterThan(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullInt16Field) GreaterThan(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-
-func (f Int16Field) GreaterEqual(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullInt16Field) GreaterEqual(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f Int16Field) In(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullInt16Field) In(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-
-func (f Int16Field) IsNotNull(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullInt16Field) IsNotNull(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullInt16Field) IsNull(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
-
-func (f Int16Field) LessThan(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullInt16Field) LessThan(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-
-func (f Int16Field) LessOrEqual(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullInt16Field) LessOrEqual(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-
-func (f Int16Field) NotEquals(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullInt16Field) NotEquals(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-
-func (f Int16Field) NotIn(v int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
-func (f NullInt16Field) NotIn(v *int16) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
+type NullInt16Field = NullNumberField[int16]
 
 // Int32Field is a component that returns a WhereClause that contains a
 // comparison based on its field and a strongly typed value.
 
-type Int32Field string
-
-type NullInt32Field string
-
-func (f Int32Field) ToValue(v int32) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f NullInt32Field) ToNullValue(v *int32) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f Int32Field) Equals(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullInt32Field) Equals(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-
-func (f Int32Field) GreaterThan(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullInt32Field) GreaterThan(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-
-func (f Int32Field) GreaterEqual(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullInt32Field) GreaterEqual(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f Int32Field) In(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullInt32Field) In(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-
-func (f Int32Field) IsNotNull(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullInt32Field) IsNotNull(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullInt32Field) IsNull(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
-
-func (f Int32Field) LessThan(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullInt32Field) LessThan(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-
-func (f Int32Field) LessOrEqual(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullInt32Field) LessOrEqual(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-
-func (f Int32Field) NotEquals(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullInt32Field) NotEquals(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
+type Int32Field = NumberField[int32]
 
-func (f Int32Field) NotIn(v int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
-func (f NullInt32Field) NotIn(v *int32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
+type NullInt32Field = NullNumberField[int32]
 
 // Int64Field is a component that returns a WhereClause that contains a
 // comparison based on its field and a strongly typed value.
 
-type Int64Field string
-
-type NullInt64Field string
+type Int64Field = NumberField[int64]
 
-func (f Int64Field) ToValue(v int64) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
+type NullInt64Field = NullNumberField[int64]
 
-func (f NullInt64Field) ToNullValue(v *int64) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
+// UintField is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value.
 
-func (f Int64Field) Equals(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullInt64Field) Equals(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
+type UintField = NumberField[uint]
 
-func (f Int64Field) GreaterThan(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullInt64Field) GreaterThan(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
+type NullUintField = NullNumberField[uint]
 
-func (f Int64Field) GreaterEqual(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullInt64Field) GreaterEqual(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f Int64Field) In(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullInt64Field) In(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
+// Uint8Field is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value.
 
-func (f Int64Field) IsNotNull(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullInt64Field) IsNotNull(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullInt64Field) IsNull(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
+type Uint8Field = NumberField[uint8]
 
-func (f Int64Field) LessThan(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullInt64Field) LessThan(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
+type NullUint8Field = NullNumberField[uint8]
 
-func (f Int64Field) LessOrEqual(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullInt64Field) LessOrEqual(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
+// Uint16Field is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value.
 
-func (f Int64Field) NotEquals(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullInt64Field) NotEquals(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
+type Uint16Field = NumberField[uint16]
 
-func (f Int64Field) NotIn(v int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
-func (f NullInt64Field) NotIn(v *int64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
+type NullUint16Field = NullNumberField[uint16]
 
-// UintField is a component that returns a WhereClause that contains a
+// Uint32Field is a component that returns a WhereClause that contains a
 // comparison based on its field and a strongly typed value.
 
-type UintField string
-
-type NullUintField string
-
-func (f UintField) ToValue(v uint) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
+type Uint32Field = NumberField[uint32]
 
-func (f NullUintField) ToNullValue(v *uint) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
+type NullUint32Field = NullNumberField[uint32]
 
-func (f UintField) Equals(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullUintField) Equals(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
+// Uint64Field is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value.
 
-func (f UintField) GreaterThan(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullUintField) GreaterThan(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
+type Uint64Field = NumberField[uint64]
 
-func (f UintField) GreaterEqual(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullUintField) GreaterEqual(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
+type NullUint64Field = NullNumberField[uint64]
 
-func (f UintField) In(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullUintField) In(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
+// Float32Field is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value.
 
-func (f UintField) IsNotNull(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullUintField) IsNotNull(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullUintField) IsNull(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
+type Float32Field = NumberField[float32]
 
-func (f UintField) LessThan(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullUintField) LessThan(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
+type NullFloat32Field = NullNumberField[float32]
 
-func (f UintField) LessOrEqual(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullUintField) LessOrEqual(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
+// Float64Field is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value.
 
-func (f UintField) NotEquals(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullUintField) NotEquals(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
+type Float64Field = NumberField[float64]
 
-func (f UintField) NotIn(v uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
-func (f NullUintField) NotIn(v *uint) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
-}
+type NullFloat64Field = NullNumberField[float64]
 
-// Uint8Field is a component that returns a WhereClause that contains a
+// Complex64Field is a component that returns a WhereClause that contains a
 // comparison based on its field and a strongly typed value.
 
-type Uint8Field string
-
-type NullUint8Field string
+type Complex64Field = ComplexField[complex64]
 
-func (f Uint8Field) ToValue(v uint8) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f NullUint8Field) ToNullValue(v *uint8) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
+type NullComplex64Field = NullComplexField[complex64]
 
-func (f Uint8Field) Equals(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullUint8Field) Equals(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
+// Complex128Field is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value.
 
-func (f Uint8Field) GreaterThan(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullUint8Field) GreaterThan(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
+type Complex128Field = ComplexField[complex128]
 
-func (f Uint8Field) GreaterEqual(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullUint8Field) GreaterEqual(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
+type NullComplex128Field = NullComplexField[complex128]
 
-func (f Uint8Field) In(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullUint8Field) In(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
+// ByteField is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value. ByteField and
+// RuneField forward to NumberField, being defined types rather than aliases so
+// that they stay distinct from Uint8Field and Int32Field.
 
-func (f Uint8Field) IsNotNull(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullUint8Field) IsNotNull(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullUint8Field) IsNull(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
+type ByteField string
 
-func (f Uint8Field) LessThan(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
-func (f NullUint8Field) LessThan(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
-}
+type NullByteField string
 
-func (f Uint8Field) LessOrEqual(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
-}
-func (f NullUint8Field) LessOrEqual(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f ByteField) ToValue(v byte) Condition {
+	return NumberField[byte](f).ToValue(v)
 }
 
-func (f Uint8Field) NotEquals(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
-}
-func (f NullUint8Field) NotEquals(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullByteField) ToNullValue(v *byte) Condition {
+	return NullNumberField[byte](f).ToNullValue(v)
 }
 
-func (f Uint8Field) NotIn(v uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f ByteField) Equals(v byte) Condition {
+	return NumberField[byte](f).Equals(v)
 }
-func (f NullUint8Field) NotIn(v *uint8) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullByteField) Equals(v *byte) Condition {
+	return NullNumberField[byte](f).Equals(v)
 }
 
-// Uint16Field is a component that returns a WhereClause that contains a
-// comparison based on its field and a strongly typed value.
-
-type Uint16Field string
-
-type NullUint16Field string
-
-func (f Uint16Field) ToValue(v uint16) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f ByteField) GreaterThan(v byte) Condition {
+	return NumberField[byte](f).GreaterThan(v)
 }
-
-func (f NullUint16Field) ToNullValue(v *uint16) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f NullByteField) GreaterThan(v *byte) Condition {
+	return NullNumberField[byte](f).GreaterThan(v)
 }
 
-func (f Uint16Field) Equals(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f ByteField) GreaterEqual(v byte) Condition {
+	return NumberField[byte](f).GreaterEqual(v)
 }
-func (f NullUint16Field) Equals(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullByteField) GreaterEqual(v *byte) Condition {
+	return NullNumberField[byte](f).GreaterEqual(v)
 }
 
-func (f Uint16Field) GreaterThan(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f ByteField) In(vs ...byte) Condition {
+	return NumberField[byte](f).In(vs...)
 }
-func (f NullUint16Field) GreaterThan(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullByteField) In(vs ...*byte) Condition {
+	return NullNumberField[byte](f).In(vs...)
 }
 
-func (f Uint16Field) GreaterEqual(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f ByteField) IsNotNull() Condition {
+	return NumberField[byte](f).IsNotNull()
 }
-func (f NullUint16Field) GreaterEqual(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f NullByteField) IsNotNull() Condition {
+	return NullNumberField[byte](f).IsNotNull()
 }
-
-func (f Uint16Field) In(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullUint16Field) In(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f NullByteField) IsNull() Condition {
+	return NullNumberField[byte](f).IsNull()
 }
 
-func (f Uint16Field) IsNotNull(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullUint16Field) IsNotNull(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f ByteField) LessThan(v byte) Condition {
+	return NumberField[byte](f).LessThan(v)
 }
-func (f NullUint16Field) IsNull(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
+func (f NullByteField) LessThan(v *byte) Condition {
+	return NullNumberField[byte](f).LessThan(v)
 }
 
-func (f Uint16Field) LessThan(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f ByteField) LessOrEqual(v byte) Condition {
+	return NumberField[byte](f).LessOrEqual(v)
 }
-func (f NullUint16Field) LessThan(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullByteField) LessOrEqual(v *byte) Condition {
+	return NullNumberField[byte](f).LessOrEqual(v)
 }
 
-func (f Uint16Field) LessOrEqual(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f ByteField) NotEquals(v byte) Condition {
+	return NumberField[byte](f).NotEquals(v)
 }
-func (f NullUint16Field) LessOrEqual(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullByteField) NotEquals(v *byte) Condition {
+	return NullNumberField[byte](f).NotEquals(v)
 }
 
-func (f Uint16Field) NotEquals(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullByteField) IsDistinctFrom(v *byte) Condition {
+	return NullNumberField[byte](f).IsDistinctFrom(v)
 }
-func (f NullUint16Field) NotEquals(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullByteField) IsNotDistinctFrom(v *byte) Condition {
+	return NullNumberField[byte](f).IsNotDistinctFrom(v)
 }
 
-func (f Uint16Field) NotIn(v uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f ByteField) EqualsValuer(v driver.Valuer) Condition {
+	return NumberField[byte](f).EqualsValuer(v)
 }
-func (f NullUint16Field) NotIn(v *uint16) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullByteField) EqualsValuer(v driver.Valuer) Condition {
+	return NullNumberField[byte](f).EqualsValuer(v)
 }
 
-// Uint32Field is a component that returns a WhereClause that contains a
-// comparison based on its field and a strongly typed value.
-
-type Uint32Field string
-
-type NullUint32Field string
-
-func (f Uint32Field) ToValue(v uint32) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f ByteField) NotEqualsValuer(v driver.Valuer) Condition {
+	return NumberField[byte](f).NotEqualsValuer(v)
 }
-
-func (f NullUint32Field) ToNullValue(v *uint32) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f NullByteField) NotEqualsValuer(v driver.Valuer) Condition {
+	return NullNumberField[byte](f).NotEqualsValuer(v)
 }
 
-func (f Uint32Field) Equals(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f ByteField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return NumberField[byte](f).CompareValuer(op, v)
 }
-func (f NullUint32Field) Equals(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullByteField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return NullNumberField[byte](f).CompareValuer(op, v)
 }
 
-func (f Uint32Field) GreaterThan(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullByteField) EqualsNull(v driver.Valuer) Condition {
+	return NullNumberField[byte](f).EqualsNull(v)
 }
-func (f NullUint32Field) GreaterThan(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullByteField) NotEqualsNull(v driver.Valuer) Condition {
+	return NullNumberField[byte](f).NotEqualsNull(v)
 }
 
-func (f Uint32Field) GreaterEqual(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f ByteField) NotIn(vs ...byte) Condition {
+	return NumberField[byte](f).NotIn(vs...)
 }
-func (f NullUint32Field) GreaterEqual(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f NullByteField) NotIn(vs ...*byte) Condition {
+	return NullNumberField[byte](f).NotIn(vs...)
 }
 
-func (f Uint32Field) In(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f ByteField) InValuers(vs ...driver.Valuer) Condition {
+	return NumberField[byte](f).InValuers(vs...)
 }
-func (f NullUint32Field) In(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f NullByteField) InValuers(vs ...driver.Valuer) Condition {
+	return NullNumberField[byte](f).InValuers(vs...)
 }
 
-func (f Uint32Field) IsNotNull(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f ByteField) NotInValuers(vs ...driver.Valuer) Condition {
+	return NumberField[byte](f).NotInValuers(vs...)
 }
-func (f NullUint32Field) IsNotNull(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullUint32Field) IsNull(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
+func (f NullByteField) NotInValuers(vs ...driver.Valuer) Condition {
+	return NullNumberField[byte](f).NotInValuers(vs...)
 }
 
-func (f Uint32Field) LessThan(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f ByteField) Between(lo, hi byte) Condition {
+	return NumberField[byte](f).Between(lo, hi)
 }
-func (f NullUint32Field) LessThan(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullByteField) Between(lo, hi *byte) Condition {
+	return NullNumberField[byte](f).Between(lo, hi)
 }
 
-func (f Uint32Field) LessOrEqual(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f ByteField) NotBetween(lo, hi byte) Condition {
+	return NumberField[byte](f).NotBetween(lo, hi)
 }
-func (f NullUint32Field) LessOrEqual(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullByteField) NotBetween(lo, hi *byte) Condition {
+	return NullNumberField[byte](f).NotBetween(lo, hi)
 }
 
-func (f Uint32Field) NotEquals(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f ByteField) InRange(lo, hi byte) Condition {
+	return NumberField[byte](f).InRange(lo, hi)
 }
-func (f NullUint32Field) NotEquals(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullByteField) InRange(lo, hi *byte) Condition {
+	return NullNumberField[byte](f).InRange(lo, hi)
 }
 
-func (f Uint32Field) NotIn(v uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f ByteField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return NumberField[byte](f).BetweenValuers(lo, hi)
 }
-func (f NullUint32Field) NotIn(v *uint32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullByteField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return NullNumberField[byte](f).BetweenValuers(lo, hi)
 }
 
-// Uint64Field is a component that returns a WhereClause that contains a
-// comparison based on its field and a strongly typed value.
-
-type Uint64Field string
-
-type NullUint64Field string
-
-func (f Uint64Field) ToValue(v uint64) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f ByteField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return NumberField[byte](f).NotBetweenValuers(lo, hi)
 }
-
-func (f NullUint64Field) ToNullValue(v *uint64) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f NullByteField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return NullNumberField[byte](f).NotBetweenValuers(lo, hi)
 }
 
-func (f Uint64Field) Equals(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f ByteField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return NumberField[byte](f).InRangeValuers(lo, hi)
 }
-func (f NullUint64Field) Equals(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullByteField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return NullNumberField[byte](f).InRangeValuers(lo, hi)
 }
 
-func (f Uint64Field) GreaterThan(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f ByteField) InQuery(sub Subquery) Condition {
+	return NumberField[byte](f).InQuery(sub)
 }
-func (f NullUint64Field) GreaterThan(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullByteField) InQuery(sub Subquery) Condition {
+	return NullNumberField[byte](f).InQuery(sub)
 }
 
-func (f Uint64Field) GreaterEqual(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f ByteField) NotInQuery(sub Subquery) Condition {
+	return NumberField[byte](f).NotInQuery(sub)
 }
-func (f NullUint64Field) GreaterEqual(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f NullByteField) NotInQuery(sub Subquery) Condition {
+	return NullNumberField[byte](f).NotInQuery(sub)
 }
 
-func (f Uint64Field) In(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f ByteField) CompareTo(op Operand, q Quantified) Condition {
+	return NumberField[byte](f).CompareTo(op, q)
 }
-func (f NullUint64Field) In(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f NullByteField) CompareTo(op Operand, q Quantified) Condition {
+	return NullNumberField[byte](f).CompareTo(op, q)
 }
 
-func (f Uint64Field) IsNotNull(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullUint64Field) IsNotNull(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f ByteField) EqualsField(other NumericColumn) Condition {
+	return NumberField[byte](f).EqualsField(other)
 }
-func (f NullUint64Field) IsNull(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
+func (f NullByteField) EqualsField(other NumericColumn) Condition {
+	return NullNumberField[byte](f).EqualsField(other)
 }
 
-func (f Uint64Field) LessThan(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f ByteField) NotEqualsField(other NumericColumn) Condition {
+	return NumberField[byte](f).NotEqualsField(other)
 }
-func (f NullUint64Field) LessThan(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullByteField) NotEqualsField(other NumericColumn) Condition {
+	return NullNumberField[byte](f).NotEqualsField(other)
 }
 
-func (f Uint64Field) LessOrEqual(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f ByteField) GreaterThanField(other NumericColumn) Condition {
+	return NumberField[byte](f).GreaterThanField(other)
 }
-func (f NullUint64Field) LessOrEqual(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullByteField) GreaterThanField(other NumericColumn) Condition {
+	return NullNumberField[byte](f).GreaterThanField(other)
 }
 
-func (f Uint64Field) NotEquals(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f ByteField) GreaterEqualField(other NumericColumn) Condition {
+	return NumberField[byte](f).GreaterEqualField(other)
 }
-func (f NullUint64Field) NotEquals(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullByteField) GreaterEqualField(other NumericColumn) Condition {
+	return NullNumberField[byte](f).GreaterEqualField(other)
 }
 
-func (f Uint64Field) NotIn(v uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f ByteField) LessThanField(other NumericColumn) Condition {
+	return NumberField[byte](f).LessThanField(other)
 }
-func (f NullUint64Field) NotIn(v *uint64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullByteField) LessThanField(other NumericColumn) Condition {
+	return NullNumberField[byte](f).LessThanField(other)
 }
 
-// ByteField is a component that returns a WhereClause that contains a
-// comparison based on its field and a strongly typed value.
-
-type ByteField string
-
-type NullByteField string
-
-func (f ByteField) ToValue(v byte) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f ByteField) LessOrEqualField(other NumericColumn) Condition {
+	return NumberField[byte](f).LessOrEqualField(other)
 }
-
-func (f NullByteField) ToNullValue(v *byte) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f NullByteField) LessOrEqualField(other NumericColumn) Condition {
+	return NullNumberField[byte](f).LessOrEqualField(other)
 }
 
-func (f ByteField) Equals(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullByteField) IsDistinctFromField(other NumericColumn) Condition {
+	return NullNumberField[byte](f).IsDistinctFromField(other)
 }
-func (f NullByteField) Equals(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullByteField) IsNotDistinctFromField(other NumericColumn) Condition {
+	return NullNumberField[byte](f).IsNotDistinctFromField(other)
 }
 
-func (f ByteField) GreaterThan(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f ByteField) Add(other NumericColumn) NumericExpr {
+	return NumberField[byte](f).Add(other)
 }
-func (f NullByteField) GreaterThan(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullByteField) Add(other NumericColumn) NumericExpr {
+	return NullNumberField[byte](f).Add(other)
 }
 
-func (f ByteField) GreaterEqual(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f ByteField) Sub(other NumericColumn) NumericExpr {
+	return NumberField[byte](f).Sub(other)
 }
-func (f NullByteField) GreaterEqual(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f NullByteField) Sub(other NumericColumn) NumericExpr {
+	return NullNumberField[byte](f).Sub(other)
 }
 
-func (f ByteField) In(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f ByteField) Mul(other NumericColumn) NumericExpr {
+	return NumberField[byte](f).Mul(other)
 }
-func (f NullByteField) In(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f NullByteField) Mul(other NumericColumn) NumericExpr {
+	return NullNumberField[byte](f).Mul(other)
 }
 
-func (f ByteField) IsNotNull(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullByteField) IsNotNull(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f ByteField) Div(other NumericColumn) NumericExpr {
+	return NumberField[byte](f).Div(other)
 }
-func (f NullByteField) IsNull(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
+func (f NullByteField) Div(other NumericColumn) NumericExpr {
+	return NullNumberField[byte](f).Div(other)
 }
 
-func (f ByteField) LessThan(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f ByteField) Mod(other NumericColumn) NumericExpr {
+	return NumberField[byte](f).Mod(other)
 }
-func (f NullByteField) LessThan(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullByteField) Mod(other NumericColumn) NumericExpr {
+	return NullNumberField[byte](f).Mod(other)
 }
 
-func (f ByteField) LessOrEqual(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f ByteField) Ref() ColumnRef {
+	return NumberField[byte](f).Ref()
 }
-func (f NullByteField) LessOrEqual(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullByteField) Ref() ColumnRef {
+	return NullNumberField[byte](f).Ref()
 }
 
-func (f ByteField) NotEquals(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f ByteField) WithAlias(alias string) ByteField {
+	return ByteField(NumberField[byte](f).WithAlias(alias))
 }
-func (f NullByteField) NotEquals(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullByteField) WithAlias(alias string) NullByteField {
+	return NullByteField(NullNumberField[byte](f).WithAlias(alias))
 }
 
-func (f ByteField) NotIn(v byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f ByteField) buildNumeric(b *builder) {
+	NumberField[byte](f).buildNumeric(b)
 }
-func (f NullByteField) NotIn(v *byte) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullByteField) buildNumeric(b *builder) {
+	NullNumberField[byte](f).buildNumeric(b)
 }
 
 // RuneField is a component that returns a WhereClause that contains a
@@ -1119,400 +556,293 @@ type RuneField string
 
 type NullRuneField string
 
-func (f RuneField) ToValue(v rune) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f RuneField) ToValue(v rune) Condition {
+	return NumberField[rune](f).ToValue(v)
 }
 
-func (f NullRuneField) ToNullValue(v *rune) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f NullRuneField) ToNullValue(v *rune) Condition {
+	return NullNumberField[rune](f).ToNullValue(v)
 }
 
-func (f RuneField) Equals(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f RuneField) Equals(v rune) Condition {
+	return NumberField[rune](f).Equals(v)
 }
-func (f NullRuneField) Equals(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullRuneField) Equals(v *rune) Condition {
+	return NullNumberField[rune](f).Equals(v)
 }
 
-func (f RuneField) GreaterThan(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f RuneField) GreaterThan(v rune) Condition {
+	return NumberField[rune](f).GreaterThan(v)
 }
-func (f NullRuneField) GreaterThan(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullRuneField) GreaterThan(v *rune) Condition {
+	return NullNumberField[rune](f).GreaterThan(v)
 }
 
-func (f RuneField) GreaterEqual(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f RuneField) GreaterEqual(v rune) Condition {
+	return NumberField[rune](f).GreaterEqual(v)
 }
-func (f NullRuneField) GreaterEqual(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f NullRuneField) GreaterEqual(v *rune) Condition {
+	return NullNumberField[rune](f).GreaterEqual(v)
 }
 
-func (f RuneField) In(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f RuneField) In(vs ...rune) Condition {
+	return NumberField[rune](f).In(vs...)
 }
-func (f NullRuneField) In(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f NullRuneField) In(vs ...*rune) Condition {
+	return NullNumberField[rune](f).In(vs...)
 }
 
-func (f RuneField) IsNotNull(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f RuneField) IsNotNull() Condition {
+	return NumberField[rune](f).IsNotNull()
 }
-func (f NullRuneField) IsNotNull(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f NullRuneField) IsNotNull() Condition {
+	return NullNumberField[rune](f).IsNotNull()
 }
-func (f NullRuneField) IsNull(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
+func (f NullRuneField) IsNull() Condition {
+	return NullNumberField[rune](f).IsNull()
 }
 
-func (f RuneField) LessThan(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f RuneField) LessThan(v rune) Condition {
+	return NumberField[rune](f).LessThan(v)
 }
-func (f NullRuneField) LessThan(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullRuneField) LessThan(v *rune) Condition {
+	return NullNumberField[rune](f).LessThan(v)
 }
 
-func (f RuneField) LessOrEqual(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f RuneField) LessOrEqual(v rune) Condition {
+	return NumberField[rune](f).LessOrEqual(v)
 }
-func (f NullRuneField) LessOrEqual(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullRuneField) LessOrEqual(v *rune) Condition {
+	return NullNumberField[rune](f).LessOrEqual(v)
 }
 
-func (f RuneField) NotEquals(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f RuneField) NotEquals(v rune) Condition {
+	return NumberField[rune](f).NotEquals(v)
 }
-func (f NullRuneField) NotEquals(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullRuneField) NotEquals(v *rune) Condition {
+	return NullNumberField[rune](f).NotEquals(v)
 }
 
-func (f RuneField) NotIn(v rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullRuneField) IsDistinctFrom(v *rune) Condition {
+	return NullNumberField[rune](f).IsDistinctFrom(v)
 }
-func (f NullRuneField) NotIn(v *rune) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullRuneField) IsNotDistinctFrom(v *rune) Condition {
+	return NullNumberField[rune](f).IsNotDistinctFrom(v)
 }
 
-// Float32Field is a component that returns a WhereClause that contains a
-// comparison based on its field and a strongly typed value.
-
-type Float32Field string
-
-type NullFloat32Field string
-
-func (f Float32Field) ToValue(v float32) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f RuneField) EqualsValuer(v driver.Valuer) Condition {
+	return NumberField[rune](f).EqualsValuer(v)
 }
-
-func (f NullFloat32Field) ToNullValue(v *float32) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f NullRuneField) EqualsValuer(v driver.Valuer) Condition {
+	return NullNumberField[rune](f).EqualsValuer(v)
 }
 
-func (f Float32Field) Equals(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f RuneField) NotEqualsValuer(v driver.Valuer) Condition {
+	return NumberField[rune](f).NotEqualsValuer(v)
 }
-func (f NullFloat32Field) Equals(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullRuneField) NotEqualsValuer(v driver.Valuer) Condition {
+	return NullNumberField[rune](f).NotEqualsValuer(v)
 }
 
-func (f Float32Field) GreaterThan(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f RuneField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return NumberField[rune](f).CompareValuer(op, v)
 }
-func (f NullFloat32Field) GreaterThan(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullRuneField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return NullNumberField[rune](f).CompareValuer(op, v)
 }
 
-func (f Float32Field) GreaterEqual(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f NullRuneField) EqualsNull(v driver.Valuer) Condition {
+	return NullNumberField[rune](f).EqualsNull(v)
 }
-func (f NullFloat32Field) GreaterEqual(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f NullRuneField) NotEqualsNull(v driver.Valuer) Condition {
+	return NullNumberField[rune](f).NotEqualsNull(v)
 }
 
-func (f Float32Field) In(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f RuneField) NotIn(vs ...rune) Condition {
+	return NumberField[rune](f).NotIn(vs...)
 }
-func (f NullFloat32Field) In(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f NullRuneField) NotIn(vs ...*rune) Condition {
+	return NullNumberField[rune](f).NotIn(vs...)
 }
 
-func (f Float32Field) IsNotNull(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullFloat32Field) IsNotNull(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f RuneField) InValuers(vs ...driver.Valuer) Condition {
+	return NumberField[rune](f).InValuers(vs...)
 }
-func (f NullFloat32Field) IsNull(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
+func (f NullRuneField) InValuers(vs ...driver.Valuer) Condition {
+	return NullNumberField[rune](f).InValuers(vs...)
 }
 
-func (f Float32Field) LessThan(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f RuneField) NotInValuers(vs ...driver.Valuer) Condition {
+	return NumberField[rune](f).NotInValuers(vs...)
 }
-func (f NullFloat32Field) LessThan(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullRuneField) NotInValuers(vs ...driver.Valuer) Condition {
+	return NullNumberField[rune](f).NotInValuers(vs...)
 }
 
-func (f Float32Field) LessOrEqual(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f RuneField) Between(lo, hi rune) Condition {
+	return NumberField[rune](f).Between(lo, hi)
 }
-func (f NullFloat32Field) LessOrEqual(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullRuneField) Between(lo, hi *rune) Condition {
+	return NullNumberField[rune](f).Between(lo, hi)
 }
 
-func (f Float32Field) NotEquals(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f RuneField) NotBetween(lo, hi rune) Condition {
+	return NumberField[rune](f).NotBetween(lo, hi)
 }
-func (f NullFloat32Field) NotEquals(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullRuneField) NotBetween(lo, hi *rune) Condition {
+	return NullNumberField[rune](f).NotBetween(lo, hi)
 }
 
-func (f Float32Field) NotIn(v float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f RuneField) InRange(lo, hi rune) Condition {
+	return NumberField[rune](f).InRange(lo, hi)
 }
-func (f NullFloat32Field) NotIn(v *float32) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullRuneField) InRange(lo, hi *rune) Condition {
+	return NullNumberField[rune](f).InRange(lo, hi)
 }
 
-// Float64Field is a component that returns a WhereClause that contains a
-// comparison based on its field and a strongly typed value.
-
-type Float64Field string
-
-type NullFloat64Field string
-
-func (f Float64Field) ToValue(v float64) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f NullFloat64Field) ToNullValue(v *float64) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f Float64Field) Equals(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullFloat64Field) Equals(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-
-func (f Float64Field) GreaterThan(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-func (f NullFloat64Field) GreaterThan(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
-}
-
-func (f Float64Field) GreaterEqual(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullFloat64Field) GreaterEqual(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f Float64Field) In(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullFloat64Field) In(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-
-func (f Float64Field) IsNotNull(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullFloat64Field) IsNotNull(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullFloat64Field) IsNull(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
-}
-
-func (f Float64Field) LessThan(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f RuneField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return NumberField[rune](f).BetweenValuers(lo, hi)
 }
-func (f NullFloat64Field) LessThan(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullRuneField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return NullNumberField[rune](f).BetweenValuers(lo, hi)
 }
 
-func (f Float64Field) LessOrEqual(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f RuneField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return NumberField[rune](f).NotBetweenValuers(lo, hi)
 }
-func (f NullFloat64Field) LessOrEqual(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullRuneField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return NullNumberField[rune](f).NotBetweenValuers(lo, hi)
 }
 
-func (f Float64Field) NotEquals(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f RuneField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return NumberField[rune](f).InRangeValuers(lo, hi)
 }
-func (f NullFloat64Field) NotEquals(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullRuneField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return NullNumberField[rune](f).InRangeValuers(lo, hi)
 }
 
-func (f Float64Field) NotIn(v float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f RuneField) InQuery(sub Subquery) Condition {
+	return NumberField[rune](f).InQuery(sub)
 }
-func (f NullFloat64Field) NotIn(v *float64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullRuneField) InQuery(sub Subquery) Condition {
+	return NullNumberField[rune](f).InQuery(sub)
 }
 
-// Complex64Field is a component that returns a WhereClause that contains a
-// comparison based on its field and a strongly typed value.
-
-type Complex64Field string
-
-type NullComplex64Field string
-
-func (f Complex64Field) ToValue(v complex64) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f RuneField) NotInQuery(sub Subquery) Condition {
+	return NumberField[rune](f).NotInQuery(sub)
 }
-
-func (f NullComplex64Field) ToNullValue(v *complex64) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f NullRuneField) NotInQuery(sub Subquery) Condition {
+	return NullNumberField[rune](f).NotInQuery(sub)
 }
 
-func (f Complex64Field) Equals(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f RuneField) CompareTo(op Operand, q Quantified) Condition {
+	return NumberField[rune](f).CompareTo(op, q)
 }
-func (f NullComplex64Field) Equals(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullRuneField) CompareTo(op Operand, q Quantified) Condition {
+	return NullNumberField[rune](f).CompareTo(op, q)
 }
 
-func (f Complex64Field) GreaterThan(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f RuneField) EqualsField(other NumericColumn) Condition {
+	return NumberField[rune](f).EqualsField(other)
 }
-func (f NullComplex64Field) GreaterThan(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullRuneField) EqualsField(other NumericColumn) Condition {
+	return NullNumberField[rune](f).EqualsField(other)
 }
 
-func (f Complex64Field) GreaterEqual(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f RuneField) NotEqualsField(other NumericColumn) Condition {
+	return NumberField[rune](f).NotEqualsField(other)
 }
-func (f NullComplex64Field) GreaterEqual(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
+func (f NullRuneField) NotEqualsField(other NumericColumn) Condition {
+	return NullNumberField[rune](f).NotEqualsField(other)
 }
 
-func (f Complex64Field) In(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f RuneField) GreaterThanField(other NumericColumn) Condition {
+	return NumberField[rune](f).GreaterThanField(other)
 }
-func (f NullComplex64Field) In(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
+func (f NullRuneField) GreaterThanField(other NumericColumn) Condition {
+	return NullNumberField[rune](f).GreaterThanField(other)
 }
 
-func (f Complex64Field) IsNotNull(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f RuneField) GreaterEqualField(other NumericColumn) Condition {
+	return NumberField[rune](f).GreaterEqualField(other)
 }
-func (f NullComplex64Field) IsNotNull(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullComplex64Field) IsNull(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
+func (f NullRuneField) GreaterEqualField(other NumericColumn) Condition {
+	return NullNumberField[rune](f).GreaterEqualField(other)
 }
 
-func (f Complex64Field) LessThan(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f RuneField) LessThanField(other NumericColumn) Condition {
+	return NumberField[rune](f).LessThanField(other)
 }
-func (f NullComplex64Field) LessThan(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullRuneField) LessThanField(other NumericColumn) Condition {
+	return NullNumberField[rune](f).LessThanField(other)
 }
 
-func (f Complex64Field) LessOrEqual(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f RuneField) LessOrEqualField(other NumericColumn) Condition {
+	return NumberField[rune](f).LessOrEqualField(other)
 }
-func (f NullComplex64Field) LessOrEqual(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullRuneField) LessOrEqualField(other NumericColumn) Condition {
+	return NullNumberField[rune](f).LessOrEqualField(other)
 }
 
-func (f Complex64Field) NotEquals(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullRuneField) IsDistinctFromField(other NumericColumn) Condition {
+	return NullNumberField[rune](f).IsDistinctFromField(other)
 }
-func (f NullComplex64Field) NotEquals(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullRuneField) IsNotDistinctFromField(other NumericColumn) Condition {
+	return NullNumberField[rune](f).IsNotDistinctFromField(other)
 }
 
-func (f Complex64Field) NotIn(v complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f RuneField) Add(other NumericColumn) NumericExpr {
+	return NumberField[rune](f).Add(other)
 }
-func (f NullComplex64Field) NotIn(v *complex64) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullRuneField) Add(other NumericColumn) NumericExpr {
+	return NullNumberField[rune](f).Add(other)
 }
 
-// Complex128Field is a component that returns a WhereClause that contains a
-// comparison based on its field and a strongly typed value.
-
-type Complex128Field string
-
-type NullComplex128Field string
-
-func (f Complex128Field) ToValue(v complex128) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
+func (f RuneField) Sub(other NumericColumn) NumericExpr {
+	return NumberField[rune](f).Sub(other)
 }
-
-func (f NullComplex128Field) ToNullValue(v *complex128) (string, interface{}) {
-	return SqlizeValueWithoutAlias(string(f), OpEquals, v)
-}
-
-func (f Complex128Field) Equals(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
-}
-func (f NullComplex128Field) Equals(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpEquals, v)
+func (f NullRuneField) Sub(other NumericColumn) NumericExpr {
+	return NullNumberField[rune](f).Sub(other)
 }
 
-func (f Complex128Field) GreaterThan(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f RuneField) Mul(other NumericColumn) NumericExpr {
+	return NumberField[rune](f).Mul(other)
 }
-func (f NullComplex128Field) GreaterThan(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreater, v)
+func (f NullRuneField) Mul(other NumericColumn) NumericExpr {
+	return NullNumberField[rune](f).Mul(other)
 }
 
-func (f Complex128Field) GreaterEqual(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-func (f NullComplex128Field) GreaterEqual(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpGreaterEquals, v)
-}
-
-func (f Complex128Field) In(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-func (f NullComplex128Field) In(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpIN, v)
-}
-
-func (f Complex128Field) IsNotNull(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
-}
-func (f NullComplex128Field) IsNotNull(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNotNull, v)
+func (f RuneField) Div(other NumericColumn) NumericExpr {
+	return NumberField[rune](f).Div(other)
 }
-func (f NullComplex128Field) IsNull(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpIsNull, v)
+func (f NullRuneField) Div(other NumericColumn) NumericExpr {
+	return NullNumberField[rune](f).Div(other)
 }
 
-func (f Complex128Field) LessThan(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f RuneField) Mod(other NumericColumn) NumericExpr {
+	return NumberField[rune](f).Mod(other)
 }
-func (f NullComplex128Field) LessThan(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpLess, v)
+func (f NullRuneField) Mod(other NumericColumn) NumericExpr {
+	return NullNumberField[rune](f).Mod(other)
 }
 
-func (f Complex128Field) LessOrEqual(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f RuneField) Ref() ColumnRef {
+	return NumberField[rune](f).Ref()
 }
-func (f NullComplex128Field) LessOrEqual(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpLessEquals, v)
+func (f NullRuneField) Ref() ColumnRef {
+	return NullNumberField[rune](f).Ref()
 }
 
-func (f Complex128Field) NotEquals(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f RuneField) WithAlias(alias string) RuneField {
+	return RuneField(NumberField[rune](f).WithAlias(alias))
 }
-func (f NullComplex128Field) NotEquals(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotEquals, v)
+func (f NullRuneField) WithAlias(alias string) NullRuneField {
+	return NullRuneField(NullNumberField[rune](f).WithAlias(alias))
 }
 
-func (f Complex128Field) NotIn(v complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f RuneField) buildNumeric(b *builder) {
+	NumberField[rune](f).buildNumeric(b)
 }
-func (f NullComplex128Field) NotIn(v *complex128) (string, interface{}) {
-	return SqlizeValue(string(f), OpNotIN, v)
+func (f NullRuneField) buildNumeric(b *builder) {
+	NullNumberField[rune](f).buildNumeric(b)
 }
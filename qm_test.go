@@ -0,0 +1,160 @@
+package qm
+
+import "testing"
+
+func TestIn(t *testing.T) {
+	one, two := 1, 2
+	runConditionTests(t, []conditionTest{
+		{"values", IntField("id").In(1, 2, 3), "id IN (?, ?, ?)", []interface{}{1, 2, 3}},
+		{"single value", StringField("name").In("a"), "name IN (?)", []interface{}{"a"}},
+		{"not in", IntField("id").NotIn(1, 2), "id NOT IN (?, ?)", []interface{}{1, 2}},
+		{"empty", IntField("id").In(), "1 = 0", nil},
+		{"empty not in", IntField("id").NotIn(), "1 = 1", nil},
+		{"nil element", NullIntField("id").In(&one, nil, &two), "id IN (?, ?) OR id IS NULL", []interface{}{&one, &two}},
+		{"nil element not in", NullIntField("id").NotIn(&one, nil), "id NOT IN (?) AND id IS NOT NULL", []interface{}{&one}},
+		{"only nil", NullIntField("id").In(nil), "id IS NULL", nil},
+		{"only nil not in", NullIntField("id").NotIn(nil), "id IS NOT NULL", nil},
+		{"combined", And(NullIntField("id").In(&one, nil), BoolField("ok").Equals(true)), "(id IN (?) OR id IS NULL) AND ok = ?", []interface{}{&one, true}},
+		{"cond list", CondList("id", OpIN, []string{"a", "b"}), "id IN (?, ?)", []interface{}{"a", "b"}},
+		{"cond scalar", Cond("id", OpIN, 7), "id IN (?)", []interface{}{7}},
+	})
+}
+
+func TestArity(t *testing.T) {
+	tests := []struct {
+		op   Operand
+		want Arity
+	}{
+		{OpIsNull, Unary},
+		{OpIsNotNull, Unary},
+		{OpEquals, Binary},
+		{OpIN, List},
+		{OpNotIN, List},
+		{OpBetween, Ternary},
+		{OpNotBetween, Ternary},
+	}
+	for _, tt := range tests {
+		if got := tt.op.Arity(); got != tt.want {
+			t.Errorf("%s.Arity() = %v, want %v", tt.op, got, tt.want)
+		}
+	}
+}
+
+func TestSqlize(t *testing.T) {
+	tests := []struct {
+		op           Operand
+		placeholders []string
+		want         string
+	}{
+		{OpIsNull, nil, "a IS NULL"},
+		{OpIsNotNull, []string{"?"}, "a IS NOT NULL"},
+		{OpEquals, nil, "a = ?"},
+		{OpEquals, []string{"$1"}, "a = $1"},
+		{OpIN, nil, "a IN (?)"},
+		{OpIN, []string{"$1", "$2"}, "a IN ($1, $2)"},
+		{OpBetween, nil, "a BETWEEN ? AND ?"},
+		{OpNotBetween, []string{"$1", "$2"}, "a NOT BETWEEN $1 AND $2"},
+	}
+	for _, tt := range tests {
+		if got := Sqlize("a", tt.op, tt.placeholders...); got != tt.want {
+			t.Errorf("Sqlize(a, %s, %q) = %q, want %q", tt.op, tt.placeholders, got, tt.want)
+		}
+	}
+}
+
+func TestUnary(t *testing.T) {
+	runConditionTests(t, []conditionTest{
+		{"is not null", IntField("a").IsNotNull(), "a IS NOT NULL", nil},
+		{"is null", NullStringField("a").IsNull(), "a IS NULL", nil},
+		{"cond ignores value", Cond("a", OpIsNull, 5), "a IS NULL", nil},
+		{"sqlize value", Cond("a", OpIsNotNull, nil), "a IS NOT NULL", nil},
+	})
+}
+
+func TestNullable(t *testing.T) {
+	s := "x"
+	runConditionTests(t, []conditionTest{
+		{"equals value", NullStringField("a").Equals(&s), "a = ?", []interface{}{&s}},
+		{"equals nil", NullStringField("a").Equals(nil), "a IS NULL", nil},
+		{"not equals nil", NullStringField("a").NotEquals(nil), "a IS NOT NULL", nil},
+		{"not equals value", NullStringField("a").NotEquals(&s), "a != ?", []interface{}{&s}},
+		{"distinct from", NullStringField("a").IsDistinctFrom(&s), "a IS DISTINCT FROM ?", []interface{}{&s}},
+		{"distinct from nil", NullStringField("a").IsDistinctFrom(nil), "a IS DISTINCT FROM ?", []interface{}{(*string)(nil)}},
+		{"not distinct from", NullStringField("a").IsNotDistinctFrom(&s), "a IS NOT DISTINCT FROM ?", []interface{}{&s}},
+		{"cond nullable nil", CondNullable("a", OpEquals, nil), "a IS NULL", nil},
+		{"cond nullable other op", CondNullable("a", OpGreater, nil), "a > ?", []interface{}{nil}},
+	})
+}
+
+func TestBetween(t *testing.T) {
+	lo, hi := 1, 9
+	runConditionTests(t, []conditionTest{
+		{"between", IntField("a").Between(1, 9), "a BETWEEN ? AND ?", []interface{}{1, 9}},
+		{"not between", IntField("a").NotBetween(1, 9), "a NOT BETWEEN ? AND ?", []interface{}{1, 9}},
+		{"in range", IntField("a").InRange(1, 9), "a >= ? AND a < ?", []interface{}{1, 9}},
+		{"null between", NullIntField("a").Between(&lo, &hi), "a BETWEEN ? AND ?", []interface{}{&lo, &hi}},
+		{"no lower bound", NullIntField("a").Between(nil, &hi), "a <= ?", []interface{}{&hi}},
+		{"no upper bound", NullIntField("a").Between(&lo, nil), "a >= ?", []interface{}{&lo}},
+		{"no bounds", NullIntField("a").Between(nil, nil), "1 = 1", nil},
+		{"not between no lower bound", NullIntField("a").NotBetween(nil, &hi), "a > ?", []interface{}{&hi}},
+		{"not between no upper bound", NullIntField("a").NotBetween(&lo, nil), "a < ?", []interface{}{&lo}},
+		{"not between no bounds", NullIntField("a").NotBetween(nil, nil), "1 = 0", nil},
+		{"in range no upper bound", NullIntField("a").InRange(&lo, nil), "a >= ?", []interface{}{&lo}},
+		{"in range no bounds", NullIntField("a").InRange(nil, nil), "1 = 1", nil},
+		{"in range nested", Or(IntField("a").InRange(1, 9), IntField("b").Equals(0)), "(a >= ? AND a < ?) OR b = ?", []interface{}{1, 9, 0}},
+		{"cond ternary", Cond("a", OpBetween, []int{1, 9}), "a BETWEEN ? AND ?", []interface{}{1, 9}},
+	})
+}
+
+func TestOperandMisuse(t *testing.T) {
+	tests := []struct {
+		name string
+		cond Condition
+		err  string
+	}{
+		{"list of a scalar", CondList("a", OpIN, 5), "qm: operand IN expects a slice, got int"},
+		{"between a scalar", Cond("a", OpBetween, 5), "qm: operand BETWEEN expects a slice of two bounds, got 5"},
+		{"between one bound", Cond("a", OpNotBetween, []int{1}), "qm: operand NOT BETWEEN expects a slice of two bounds, got []int{1}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cond.Err(); err == nil || err.Error() != tt.err {
+				t.Errorf("Err() = %v, want %s", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestColumnComparisons(t *testing.T) {
+	runConditionTests(t, []conditionTest{
+		{"numeric", IntField("a").EqualsField(Int64Field("b")), "a = b", nil},
+		{"numeric mixed", Float64Field("a").GreaterThanField(NullIntField("b")), "a > b", nil},
+		{"numeric ordering", NullIntField("a").LessOrEqualField(IntField("b")), "a <= b", nil},
+		{"numeric distinct", NullIntField("a").IsDistinctFromField(IntField("b")), "a IS DISTINCT FROM b", nil},
+		{"string", StringField("u.name").NotEqualsField(NullStringField("p.name")), "u.name != p.name", nil},
+		{"bool", BoolField("a").EqualsField(NullBoolField("b")), "a = b", nil},
+		{"complex", Complex128Field("a").EqualsField(Complex64Field("b")), "a = b", nil},
+		{"complex distinct", NullComplex128Field("a").IsNotDistinctFromField(Complex128Field("b")), "a IS NOT DISTINCT FROM b", nil},
+		{"time", TimeField("a").LessThanField(NullTimeField("b")), "a < b", nil},
+		{"with values", And(IntField("a").EqualsField(IntField("b")), IntField("c").Equals(1)), "a = b AND c = ?", []interface{}{1}},
+	})
+}
+
+// The column interfaces only accept fields of a compatible type.
+var (
+	_ NumericColumn = IntField("")
+	_ NumericColumn = NullFloat64Field("")
+	_ StringColumn  = StringField("")
+	_ BoolColumn    = NullBoolField("")
+	_ ComplexColumn = Complex64Field("")
+	_ ComplexColumn = NullComplex128Field("")
+	_ TimeColumn    = TimeField("")
+)
+
+func TestComplexIsNotNumeric(t *testing.T) {
+	for _, f := range []interface{}{Complex64Field(""), NullComplex128Field("")} {
+		if _, ok := f.(NumericColumn); ok {
+			t.Errorf("%T implements NumericColumn", f)
+		}
+	}
+}
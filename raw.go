@@ -0,0 +1,199 @@
+package qm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Strict makes Raw and NewSubquery panic when their placeholders do not
+// match their arguments or cannot be told apart from string contents.
+// Otherwise the mistake is reported as a *PlaceholderError or an *EscapeError
+// by the condition's Err and ToSQL, while SQL, Args and Tuple panic when
+// rendering it, so that it never reaches the database. Strict is meant to be
+// turned on in tests and development builds, where mistakes then surface
+// where the condition is built. It also makes the typed fields that convert
+// their values, such as JSONField, panic when given a value they cannot
+// convert.
+var Strict bool
+
+// PlaceholderError reports a raw SQL fragment whose number of ? placeholders
+// differs from the number of arguments it was given.
+type PlaceholderError struct {
+	SQL          string
+	Placeholders int
+	Args         int
+}
+
+func (e *PlaceholderError) Error() string {
+	return fmt.Sprintf("qm: %q has %d placeholders but %d args", e.SQL, e.Placeholders, e.Args)
+}
+
+// EscapeError reports a raw SQL fragment with a backslash before a quote
+// inside a quoted string, as in 'it\'s', which MySQL reads as an escaped quote
+// and PostgreSQL and the SQL standard as the end of the string. As the
+// placeholders that follow could be taken for string contents or the other
+// way around, such fragments are rejected: quotes are doubled instead, as in
+// 'it”s', or the string is bound as an argument. Backslashes are accepted in
+// PostgreSQL E'...' strings, which always read them as escapes.
+type EscapeError struct {
+	SQL    string
+	Offset int // of the backslash
+}
+
+func (e *EscapeError) Error() string {
+	return fmt.Sprintf("qm: %q has a backslash before a quote at offset %d, which databases read differently", e.SQL, e.Offset)
+}
+
+// Raw returns a condition made of the SQL fragment sql whose ? placeholders
+// are bound to args in order. A literal question mark is written as ??;
+// question marks inside quoted strings and identifiers, dollar-quoted strings
+// and comments are left alone. The fragment is parenthesized when combined
+// with other conditions.
+func Raw(sql string, args ...interface{}) Condition {
+	return Condition{build: raw(sql, args), compound: true}
+}
+
+func raw(sql string, args []interface{}) expr {
+	n := 0
+	err := walkRaw(sql, func(string) {}, func() { n++ }, func() {})
+	if err == nil && n != len(args) {
+		err = &PlaceholderError{SQL: sql, Placeholders: n, Args: len(args)}
+	}
+	if err != nil {
+		if Strict {
+			panic(err)
+		}
+		return func(b *builder) {
+			b.writeString(sql)
+			b.setErr(err)
+		}
+	}
+	return func(b *builder) {
+		i := 0
+		walkRaw(sql, b.writeString, func() {
+			b.writeArg(args[i])
+			i++
+		}, b.writeQuestionMark)
+	}
+}
+
+// walkRaw splits sql at its placeholders, calling text with the SQL between
+// them, placeholder for every ? and question for every ?? escape. Quoted
+// strings and identifiers, E'...' strings with backslash escapes, dollar-quoted
+// strings and comments are passed to text as they are. It stops at the first
+// quote preceded by a backslash outside of an E'...' string, returning an
+// *EscapeError.
+func walkRaw(sql string, text func(string), placeholder, question func()) error {
+	start := 0
+	for i := 0; i < len(sql); i++ {
+		switch c := sql[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			escapes := c == '\'' && i > 0 && (sql[i-1] == 'E' || sql[i-1] == 'e') &&
+				(i == 1 || !isIdentByte(sql[i-2]))
+			end, ok := skipQuoted(sql, i, escapes)
+			if !ok {
+				return &EscapeError{SQL: sql, Offset: end - 1}
+			}
+			i = end
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			if n := strings.IndexByte(sql[i:], '\n'); n >= 0 {
+				i += n
+			} else {
+				i = len(sql)
+			}
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			i = skipBlockComment(sql, i)
+		case c == '$' && (i == 0 || !isIdentByte(sql[i-1])):
+			if tag := dollarTag(sql[i:]); tag != "" {
+				if n := strings.Index(sql[i+len(tag):], tag); n >= 0 {
+					i += len(tag) + n + len(tag) - 1
+				} else {
+					i = len(sql)
+				}
+			}
+		case c == '?':
+			text(sql[start:i])
+			if i+1 < len(sql) && sql[i+1] == '?' {
+				question()
+				i++
+			} else {
+				placeholder()
+			}
+			start = i + 1
+		}
+	}
+	text(sql[start:])
+	return nil
+}
+
+// skipQuoted returns the index of the quote closing the string or identifier
+// opened at i, where doubled quotes stand for themselves, as do quotes
+// escaped by a backslash when escapes is set. Otherwise a quote other than a
+// backtick that follows an odd number of backslashes is ambiguous, MySQL
+// reading it as escaped, and skipQuoted returns its index and false.
+func skipQuoted(sql string, i int, escapes bool) (int, bool) {
+	q := sql[i]
+	backslashes := 0
+	for i++; i < len(sql); i++ {
+		switch {
+		case sql[i] == '\\' && escapes:
+			i++
+		case sql[i] == '\\':
+			backslashes++
+			continue
+		case sql[i] != q:
+		case q != '`' && backslashes%2 == 1:
+			return i, false
+		case i+1 < len(sql) && sql[i+1] == q:
+			i++
+		default:
+			return i, true
+		}
+		backslashes = 0
+	}
+	return i, true
+}
+
+// skipBlockComment returns the index of the last character of the block
+// comment opened at i, which may nest as it does in PostgreSQL.
+func skipBlockComment(sql string, i int) int {
+	depth := 0
+	for ; i < len(sql); i++ {
+		switch {
+		case strings.HasPrefix(sql[i:], "/*"):
+			depth++
+			i++
+		case strings.HasPrefix(sql[i:], "*/"):
+			depth--
+			i++
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return i
+}
+
+// dollarTag returns the opening tag of the dollar-quoted string s starts
+// with, such as $$ or $body$, or "" if s does not start with one. Positional
+// parameters such as $1 are not tags.
+func dollarTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '$':
+			return s[:i+1]
+		case c >= '0' && c <= '9':
+			if i == 1 {
+				return ""
+			}
+		case !isIdentByte(c):
+			return ""
+		}
+	}
+	return ""
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
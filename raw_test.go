@@ -0,0 +1,135 @@
+package qm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestWalkRaw(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want []string
+	}{
+		{"placeholders", "a = ? AND b = ?", []string{"a = ", "?", " AND b = ", "?", ""}},
+		{"escaped question mark", "data ?? 'k' AND a = ?", []string{"data ", "??", " 'k' AND a = ", "?", ""}},
+		{"string", "a = '?' AND b = ?", []string{"a = '?' AND b = ", "?", ""}},
+		{"doubled quote", "a = 'it''s ?' AND b = ?", []string{"a = 'it''s ?' AND b = ", "?", ""}},
+		{"identifier", `"a?" = ?`, []string{`"a?" = `, "?", ""}},
+		{"backticks", "`a?` = ?", []string{"`a?` = ", "?", ""}},
+		{"line comment", "a = ? -- why?\nAND b = ?", []string{"a = ", "?", " -- why?\nAND b = ", "?", ""}},
+		{"line comment at end", "a = ? -- why?", []string{"a = ", "?", " -- why?"}},
+		{"block comment", "a = ? /* b = ? */", []string{"a = ", "?", " /* b = ? */"}},
+		{"nested block comment", "/* /* ? */ ? */ a = ?", []string{"/* /* ? */ ? */ a = ", "?", ""}},
+		{"escape string", `a = E'\'?' AND b = ?`, []string{`a = E'\'?' AND b = `, "?", ""}},
+		{"escaped backslash", `a = 'C:\\' AND b = ?`, []string{`a = 'C:\\' AND b = `, "?", ""}},
+		{"backslash before other characters", `a = '\d?' AND b = ?`, []string{`a = '\d?' AND b = `, "?", ""}},
+		{"backslash in backticks", "`a\\` = ?", []string{"`a\\` = ", "?", ""}},
+		{"dollar quoted", "a = $$?$$ AND b = ?", []string{"a = $$?$$ AND b = ", "?", ""}},
+		{"tagged dollar quoted", "a = $x$ $$ ? $x$ AND b = ?", []string{"a = $x$ $$ ? $x$ AND b = ", "?", ""}},
+		{"positional parameter", "a = $1 AND b = ?", []string{"a = $1 AND b = ", "?", ""}},
+		{"dollar in identifier", "a$b$ = ?", []string{"a$b$ = ", "?", ""}},
+		{"unterminated string", "a = ? AND b = '?", []string{"a = ", "?", " AND b = '?"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			walkRaw(tt.sql,
+				func(s string) { got = append(got, s) },
+				func() { got = append(got, "?") },
+				func() { got = append(got, "??") })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("walkRaw(%q) = %q, want %q", tt.sql, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRaw(t *testing.T) {
+	runConditionTests(t, []conditionTest{
+		{"raw", Raw("lower(name) = ?", "x"), "lower(name) = ?", []interface{}{"x"}},
+		{"combined", And(IntField("a").Equals(1), Raw("b = ? OR c = ?", 2, 3)), "a = ? AND (b = ? OR c = ?)", []interface{}{1, 2, 3}},
+		{"escaped question mark", Raw("data ?? ?", "k"), `data \? ?`, []interface{}{"k"}},
+		{"comment", Raw("a = ? -- no ? here", 1), "a = ? -- no ? here", []interface{}{1}},
+	})
+}
+
+func TestRawPlaceholderMismatch(t *testing.T) {
+	tests := []struct {
+		sql  string
+		args []interface{}
+	}{
+		{"a = ?", nil},
+		{"a = ? AND b = ?", []interface{}{1}},
+		{"a = '?'", []interface{}{1}},
+		{"a = ? -- ?", []interface{}{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			c := Raw(tt.sql, tt.args...)
+			var perr *PlaceholderError
+			if err := c.Err(); !errors.As(err, &perr) {
+				t.Fatalf("Err() = %v, want a *PlaceholderError", err)
+			}
+			if _, _, err := c.ToSQL(Postgres); err == nil {
+				t.Error("ToSQL returned no error")
+			}
+			for name, render := range map[string]func(){
+				"SQL":   func() { c.SQL() },
+				"Args":  func() { c.Args() },
+				"Tuple": func() { c.Tuple() },
+			} {
+				if !panics(render) {
+					t.Errorf("%s did not panic", name)
+				}
+			}
+		})
+	}
+}
+
+func TestRawBackslashQuote(t *testing.T) {
+	tests := []struct {
+		sql    string
+		offset int
+	}{
+		{`a = 'it\'s ?' AND b = ?`, 7},
+		{`a = 'x\\\'' AND b = ?`, 8},
+		{`a = 'x\'' AND b = ?`, 6},
+		{`"a\"" = ?`, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			c := Raw(tt.sql, 1)
+			var eerr *EscapeError
+			if err := c.Err(); !errors.As(err, &eerr) || eerr.Offset != tt.offset {
+				t.Fatalf("Err() = %v, want an *EscapeError at offset %d", err, tt.offset)
+			}
+			if !panics(func() { c.SQL() }) {
+				t.Error("SQL did not panic")
+			}
+		})
+	}
+}
+
+func TestRawStrict(t *testing.T) {
+	Strict = true
+	defer func() { Strict = false }()
+	if !panics(func() { Raw("a = ?") }) {
+		t.Error("Raw did not panic")
+	}
+	if !panics(func() { NewSubquery("SELECT ?") }) {
+		t.Error("NewSubquery did not panic")
+	}
+	if panics(func() { Raw("a = ?", 1) }) {
+		t.Error("Raw panicked on a valid fragment")
+	}
+}
+
+func panics(f func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	f()
+	return false
+}
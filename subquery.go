@@ -0,0 +1,90 @@
+package qm
+
+import (
+	"fmt"
+)
+
+// Subquery is a SELECT statement together with the arguments bound to its ?
+// placeholders. It can be used as the right-hand side of IN, EXISTS and
+// quantified comparisons, its arguments being merged into those of the outer
+// condition.
+type Subquery struct {
+	sql expr
+}
+
+// NewSubquery returns the subquery sql whose placeholders are bound to args.
+// Placeholders are checked against args as they are by Raw.
+func NewSubquery(sql string, args ...interface{}) Subquery {
+	return Subquery{sql: raw(sql, args)}
+}
+
+func (s Subquery) build(b *builder) {
+	b.writeString("(")
+	s.sql(b)
+	b.writeString(")")
+}
+
+// Quantified is a subquery under an ANY or ALL quantifier, compared against
+// a column with CondQuantified.
+type Quantified struct {
+	quantifier string
+	sub        Subquery
+}
+
+// AnyOf quantifies sub so that a comparison holds when it holds for at least
+// one row returned by sub.
+func AnyOf(sub Subquery) Quantified {
+	return Quantified{quantifier: "ANY", sub: sub}
+}
+
+// AllOf quantifies sub so that a comparison holds when it holds for every row
+// returned by sub.
+func AllOf(sub Subquery) Quantified {
+	return Quantified{quantifier: "ALL", sub: sub}
+}
+
+// CondQuery returns a condition comparing col to the result of sub using op,
+// which is usually OpIN or OpNotIN, or a binary operand for subqueries
+// returning a single value.
+func CondQuery(col string, op Operand, sub Subquery) Condition {
+	return Condition{build: func(b *builder) {
+		b.writeColumn(col)
+		b.writeString(" ")
+		b.writeOperand(op)
+		b.writeString(" ")
+		sub.build(b)
+	}}
+}
+
+// CondQuantified returns a condition comparing col to the rows of a
+// quantified subquery using the binary operand op, as in col > ALL (...).
+func CondQuantified(col string, op Operand, q Quantified) Condition {
+	return Condition{build: func(b *builder) {
+		if op.Arity() != Binary {
+			b.setErr(fmt.Errorf("qm: operand %q cannot be used with %s", op, q.quantifier))
+		}
+		b.writeColumn(col)
+		b.writeString(" ")
+		b.writeOperand(op)
+		b.writeString(" ")
+		b.writeString(q.quantifier)
+		b.writeString(" ")
+		q.sub.build(b)
+	}}
+}
+
+// Exists returns a condition that holds when sub returns at least one row.
+func Exists(sub Subquery) Condition {
+	return Condition{build: func(b *builder) {
+		b.writeString("EXISTS ")
+		sub.build(b)
+	}}
+}
+
+// NotExists returns a condition that holds when sub returns no rows.
+func NotExists(sub Subquery) Condition {
+	return Condition{build: func(b *builder) {
+		b.writeString("NOT EXISTS ")
+		sub.build(b)
+	}}
+}
@@ -0,0 +1,35 @@
+package qm
+
+import "testing"
+
+func TestSubquery(t *testing.T) {
+	active := NewSubquery("SELECT user_id FROM sessions WHERE active = ?", true)
+	runConditionTests(t, []conditionTest{
+		{"in", IntField("id").InQuery(active), "id IN (SELECT user_id FROM sessions WHERE active = ?)", []interface{}{true}},
+		{"not in", NullIntField("id").NotInQuery(active), "id NOT IN (SELECT user_id FROM sessions WHERE active = ?)", []interface{}{true}},
+		{"exists", Exists(NewSubquery("SELECT 1 FROM orders o WHERE o.user_id = u.id")), "EXISTS (SELECT 1 FROM orders o WHERE o.user_id = u.id)", nil},
+		{"not exists", NotExists(NewSubquery("SELECT 1")), "NOT EXISTS (SELECT 1)", nil},
+		{"any", IntField("id").CompareTo(OpEquals, AnyOf(active)), "id = ANY (SELECT user_id FROM sessions WHERE active = ?)", []interface{}{true}},
+		{"all", Float64Field("price").CompareTo(OpGreater, AllOf(NewSubquery("SELECT price FROM items WHERE kind = ?", "x"))), "price > ALL (SELECT price FROM items WHERE kind = ?)", []interface{}{"x"}},
+		{"args in order", And(IntField("a").Equals(1), IntField("id").InQuery(active), IntField("b").Equals(2)), "a = ? AND id IN (SELECT user_id FROM sessions WHERE active = ?) AND b = ?", []interface{}{1, true, 2}},
+	})
+}
+
+func TestCondQuantifiedOperand(t *testing.T) {
+	tests := []struct {
+		op      Operand
+		wantErr bool
+	}{
+		{OpEquals, false},
+		{OpLessEquals, false},
+		{OpIN, true},
+		{OpIsNull, true},
+		{OpBetween, true},
+	}
+	for _, tt := range tests {
+		err := CondQuantified("a", tt.op, AnyOf(NewSubquery("SELECT 1"))).Err()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CondQuantified(%s).Err() = %v, want error %v", tt.op, err, tt.wantErr)
+		}
+	}
+}
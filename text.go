@@ -0,0 +1,381 @@
+package qm
+
+import "database/sql/driver"
+
+// TextField is a column of strings compared to values of type T. Besides the
+// comparisons it matches LIKE and regular expression patterns, and it can be
+// compared to any other text column.
+type TextField[T Text] string
+
+// NullTextField is the nullable twin of TextField.
+type NullTextField[T Text] string
+
+func (f TextField[T]) ToValue(v T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f NullTextField[T]) ToNullValue(v *T) Condition {
+	return CondWithoutAlias(string(f), OpEquals, v)
+}
+
+func (f TextField[T]) Equals(v T) Condition {
+	return Cond(string(f), OpEquals, v)
+}
+func (f NullTextField[T]) Equals(v *T) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f TextField[T]) GreaterThan(v T) Condition {
+	return Cond(string(f), OpGreater, v)
+}
+func (f NullTextField[T]) GreaterThan(v *T) Condition {
+	return Cond(string(f), OpGreater, v)
+}
+
+func (f TextField[T]) GreaterEqual(v T) Condition {
+	return Cond(string(f), OpGreaterEquals, v)
+}
+func (f NullTextField[T]) GreaterEqual(v *T) Condition {
+	return Cond(string(f), OpGreaterEquals, v)
+}
+
+func (f TextField[T]) In(vs ...T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullTextField[T]) In(vs ...*T) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f TextField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullTextField[T]) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullTextField[T]) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f TextField[T]) LessThan(v T) Condition {
+	return Cond(string(f), OpLess, v)
+}
+func (f NullTextField[T]) LessThan(v *T) Condition {
+	return Cond(string(f), OpLess, v)
+}
+
+func (f TextField[T]) LessOrEqual(v T) Condition {
+	return Cond(string(f), OpLessEquals, v)
+}
+func (f NullTextField[T]) LessOrEqual(v *T) Condition {
+	return Cond(string(f), OpLessEquals, v)
+}
+
+func (f TextField[T]) NotEquals(v T) Condition {
+	return Cond(string(f), OpNotEquals, v)
+}
+func (f NullTextField[T]) NotEquals(v *T) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f NullTextField[T]) IsDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsDistinctFrom, v)
+}
+func (f NullTextField[T]) IsNotDistinctFrom(v *T) Condition {
+	return Cond(string(f), OpIsNotDistinctFrom, v)
+}
+
+func (f TextField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullTextField[T]) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+
+func (f TextField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+func (f NullTextField[T]) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f TextField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+func (f NullTextField[T]) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, v)
+}
+
+// EqualsNull compares the field to a database/sql null type, such as
+// sql.NullInt64, which stands for NULL unless Valid is set.
+func (f NullTextField[T]) EqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, v)
+}
+func (f NullTextField[T]) NotEqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, v)
+}
+
+func (f TextField[T]) NotIn(vs ...T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullTextField[T]) NotIn(vs ...*T) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f TextField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+func (f NullTextField[T]) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, vs)
+}
+
+func (f TextField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+func (f NullTextField[T]) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, vs)
+}
+
+func (f TextField[T]) Between(lo, hi T) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+func (f NullTextField[T]) Between(lo, hi *T) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+
+func (f TextField[T]) NotBetween(lo, hi T) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+func (f NullTextField[T]) NotBetween(lo, hi *T) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+
+func (f TextField[T]) InRange(lo, hi T) Condition {
+	return CondRange(string(f), lo, hi)
+}
+func (f NullTextField[T]) InRange(lo, hi *T) Condition {
+	return CondRange(string(f), lo, hi)
+}
+
+func (f TextField[T]) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+func (f NullTextField[T]) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpBetween, lo, hi)
+}
+
+func (f TextField[T]) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+func (f NullTextField[T]) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return CondBetween(string(f), OpNotBetween, lo, hi)
+}
+
+func (f TextField[T]) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return CondRange(string(f), lo, hi)
+}
+func (f NullTextField[T]) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return CondRange(string(f), lo, hi)
+}
+
+func (f TextField[T]) Like(v string) Condition {
+	return CondLike(string(f), OpLike, v)
+}
+func (f NullTextField[T]) Like(v string) Condition {
+	return CondLike(string(f), OpLike, v)
+}
+
+func (f TextField[T]) NotLike(v string) Condition {
+	return CondLike(string(f), OpNotLike, v)
+}
+func (f NullTextField[T]) NotLike(v string) Condition {
+	return CondLike(string(f), OpNotLike, v)
+}
+
+func (f TextField[T]) ILike(v string) Condition {
+	return CondLike(string(f), OpILike, v)
+}
+func (f NullTextField[T]) ILike(v string) Condition {
+	return CondLike(string(f), OpILike, v)
+}
+
+func (f TextField[T]) NotILike(v string) Condition {
+	return CondLike(string(f), OpNotILike, v)
+}
+func (f NullTextField[T]) NotILike(v string) Condition {
+	return CondLike(string(f), OpNotILike, v)
+}
+
+func (f TextField[T]) StartsWith(v string) Condition {
+	return CondLike(string(f), OpLike, EscapeLike(v)+"%")
+}
+func (f NullTextField[T]) StartsWith(v string) Condition {
+	return CondLike(string(f), OpLike, EscapeLike(v)+"%")
+}
+
+func (f TextField[T]) EndsWith(v string) Condition {
+	return CondLike(string(f), OpLike, "%"+EscapeLike(v))
+}
+func (f NullTextField[T]) EndsWith(v string) Condition {
+	return CondLike(string(f), OpLike, "%"+EscapeLike(v))
+}
+
+func (f TextField[T]) Contains(v string) Condition {
+	return CondLike(string(f), OpLike, "%"+EscapeLike(v)+"%")
+}
+func (f NullTextField[T]) Contains(v string) Condition {
+	return CondLike(string(f), OpLike, "%"+EscapeLike(v)+"%")
+}
+
+func (f TextField[T]) IStartsWith(v string) Condition {
+	return CondLike(string(f), OpILike, EscapeLike(v)+"%")
+}
+func (f NullTextField[T]) IStartsWith(v string) Condition {
+	return CondLike(string(f), OpILike, EscapeLike(v)+"%")
+}
+
+func (f TextField[T]) IEndsWith(v string) Condition {
+	return CondLike(string(f), OpILike, "%"+EscapeLike(v))
+}
+func (f NullTextField[T]) IEndsWith(v string) Condition {
+	return CondLike(string(f), OpILike, "%"+EscapeLike(v))
+}
+
+func (f TextField[T]) IContains(v string) Condition {
+	return CondLike(string(f), OpILike, "%"+EscapeLike(v)+"%")
+}
+func (f NullTextField[T]) IContains(v string) Condition {
+	return CondLike(string(f), OpILike, "%"+EscapeLike(v)+"%")
+}
+
+func (f TextField[T]) Matches(pattern string) Condition {
+	return CondRegexp(string(f), OpMatches, pattern)
+}
+func (f NullTextField[T]) Matches(pattern string) Condition {
+	return CondRegexp(string(f), OpMatches, pattern)
+}
+
+func (f TextField[T]) MatchesInsensitive(pattern string) Condition {
+	return CondRegexp(string(f), OpMatchesInsensitive, pattern)
+}
+func (f NullTextField[T]) MatchesInsensitive(pattern string) Condition {
+	return CondRegexp(string(f), OpMatchesInsensitive, pattern)
+}
+
+func (f TextField[T]) NotMatches(pattern string) Condition {
+	return CondRegexp(string(f), OpNotMatches, pattern)
+}
+func (f NullTextField[T]) NotMatches(pattern string) Condition {
+	return CondRegexp(string(f), OpNotMatches, pattern)
+}
+
+func (f TextField[T]) NotMatchesInsensitive(pattern string) Condition {
+	return CondRegexp(string(f), OpNotMatchesInsensitive, pattern)
+}
+func (f NullTextField[T]) NotMatchesInsensitive(pattern string) Condition {
+	return CondRegexp(string(f), OpNotMatchesInsensitive, pattern)
+}
+
+func (f TextField[T]) SimilarTo(pattern string) Condition {
+	return CondRegexp(string(f), OpSimilarTo, pattern)
+}
+func (f NullTextField[T]) SimilarTo(pattern string) Condition {
+	return CondRegexp(string(f), OpSimilarTo, pattern)
+}
+
+func (f TextField[T]) NotSimilarTo(pattern string) Condition {
+	return CondRegexp(string(f), OpNotSimilarTo, pattern)
+}
+func (f NullTextField[T]) NotSimilarTo(pattern string) Condition {
+	return CondRegexp(string(f), OpNotSimilarTo, pattern)
+}
+
+func (f TextField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+func (f NullTextField[T]) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+
+func (f TextField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+func (f NullTextField[T]) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+
+func (f TextField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+func (f NullTextField[T]) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+
+func (f TextField[T]) EqualsField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildString)
+}
+func (f NullTextField[T]) EqualsField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildString)
+}
+
+func (f TextField[T]) NotEqualsField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildString)
+}
+func (f NullTextField[T]) NotEqualsField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildString)
+}
+
+func (f TextField[T]) GreaterThanField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpGreater, other.buildString)
+}
+func (f NullTextField[T]) GreaterThanField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpGreater, other.buildString)
+}
+
+func (f TextField[T]) GreaterEqualField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpGreaterEquals, other.buildString)
+}
+func (f NullTextField[T]) GreaterEqualField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpGreaterEquals, other.buildString)
+}
+
+func (f TextField[T]) LessThanField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpLess, other.buildString)
+}
+func (f NullTextField[T]) LessThanField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpLess, other.buildString)
+}
+
+func (f TextField[T]) LessOrEqualField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpLessEquals, other.buildString)
+}
+func (f NullTextField[T]) LessOrEqualField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpLessEquals, other.buildString)
+}
+
+func (f NullTextField[T]) IsDistinctFromField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpIsDistinctFrom, other.buildString)
+}
+func (f NullTextField[T]) IsNotDistinctFromField(other StringColumn) Condition {
+	return compareExpr(column(string(f)), OpIsNotDistinctFrom, other.buildString)
+}
+
+func (f TextField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullTextField[T]) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f TextField[T]) WithAlias(alias string) TextField[T] {
+	return TextField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullTextField[T]) WithAlias(alias string) NullTextField[T] {
+	return NullTextField[T](parseColumn(string(f)).WithTable(alias).String())
+}
+
+func (f TextField[T]) buildString(b *builder) {
+	b.writeColumn(string(f))
+}
+func (f NullTextField[T]) buildString(b *builder) {
+	b.writeColumn(string(f))
+}
@@ -0,0 +1,335 @@
+package qm
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// now is the clock relative time predicates are computed against.
+var now = time.Now
+
+// CondWithinLast returns a condition checking that col lies within the
+// duration d before the current time, both computed on the Go side so that
+// the database clock and time zone settings do not matter.
+func CondWithinLast(col string, d time.Duration) Condition {
+	t := now()
+	return CondBetween(col, OpBetween, t.Add(-d), t)
+}
+
+// CondOlderThan returns a condition checking that col lies more than the
+// duration d before the current time.
+func CondOlderThan(col string, d time.Duration) Condition {
+	return Cond(col, OpLess, now().Add(-d))
+}
+
+// CondOnDate returns a condition checking that col lies on the calendar date
+// that date falls on in the time zone loc, as the half-open range from that
+// midnight to the next one. A nil loc stands for UTC.
+func CondOnDate(col string, date time.Time, loc *time.Location) Condition {
+	if loc == nil {
+		loc = time.UTC
+	}
+	y, m, d := date.In(loc).Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	return CondRange(col, start, start.AddDate(0, 0, 1))
+}
+
+// TimeField is a component that returns a WhereClause that contains a
+// comparison based on its field and a strongly typed value. TimeField and
+// NullTimeField forward to OrderedField, being defined types so that they can
+// have time predicates of their own.
+
+type TimeField string
+
+type NullTimeField string
+
+func (f TimeField) ToValue(v time.Time) Condition {
+	return OrderedField[time.Time](f).ToValue(v)
+}
+
+func (f NullTimeField) ToNullValue(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).ToNullValue(v)
+}
+
+func (f TimeField) Equals(v time.Time) Condition {
+	return OrderedField[time.Time](f).Equals(v)
+}
+func (f NullTimeField) Equals(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).Equals(v)
+}
+
+func (f TimeField) GreaterThan(v time.Time) Condition {
+	return OrderedField[time.Time](f).GreaterThan(v)
+}
+func (f NullTimeField) GreaterThan(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).GreaterThan(v)
+}
+
+func (f TimeField) GreaterEqual(v time.Time) Condition {
+	return OrderedField[time.Time](f).GreaterEqual(v)
+}
+func (f NullTimeField) GreaterEqual(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).GreaterEqual(v)
+}
+
+func (f TimeField) In(vs ...time.Time) Condition {
+	return OrderedField[time.Time](f).In(vs...)
+}
+func (f NullTimeField) In(vs ...*time.Time) Condition {
+	return NullOrderedField[time.Time](f).In(vs...)
+}
+
+func (f TimeField) IsNotNull() Condition {
+	return OrderedField[time.Time](f).IsNotNull()
+}
+func (f NullTimeField) IsNotNull() Condition {
+	return NullOrderedField[time.Time](f).IsNotNull()
+}
+func (f NullTimeField) IsNull() Condition {
+	return NullOrderedField[time.Time](f).IsNull()
+}
+
+func (f TimeField) LessThan(v time.Time) Condition {
+	return OrderedField[time.Time](f).LessThan(v)
+}
+func (f NullTimeField) LessThan(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).LessThan(v)
+}
+
+func (f TimeField) LessOrEqual(v time.Time) Condition {
+	return OrderedField[time.Time](f).LessOrEqual(v)
+}
+func (f NullTimeField) LessOrEqual(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).LessOrEqual(v)
+}
+
+func (f TimeField) NotEquals(v time.Time) Condition {
+	return OrderedField[time.Time](f).NotEquals(v)
+}
+func (f NullTimeField) NotEquals(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).NotEquals(v)
+}
+
+func (f NullTimeField) IsDistinctFrom(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).IsDistinctFrom(v)
+}
+func (f NullTimeField) IsNotDistinctFrom(v *time.Time) Condition {
+	return NullOrderedField[time.Time](f).IsNotDistinctFrom(v)
+}
+
+func (f TimeField) EqualsValuer(v driver.Valuer) Condition {
+	return OrderedField[time.Time](f).EqualsValuer(v)
+}
+func (f NullTimeField) EqualsValuer(v driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).EqualsValuer(v)
+}
+
+func (f TimeField) NotEqualsValuer(v driver.Valuer) Condition {
+	return OrderedField[time.Time](f).NotEqualsValuer(v)
+}
+func (f NullTimeField) NotEqualsValuer(v driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).NotEqualsValuer(v)
+}
+
+func (f TimeField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return OrderedField[time.Time](f).CompareValuer(op, v)
+}
+func (f NullTimeField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).CompareValuer(op, v)
+}
+
+func (f NullTimeField) EqualsNull(v driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).EqualsNull(v)
+}
+func (f NullTimeField) NotEqualsNull(v driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).NotEqualsNull(v)
+}
+
+func (f TimeField) NotIn(vs ...time.Time) Condition {
+	return OrderedField[time.Time](f).NotIn(vs...)
+}
+func (f NullTimeField) NotIn(vs ...*time.Time) Condition {
+	return NullOrderedField[time.Time](f).NotIn(vs...)
+}
+
+func (f TimeField) InValuers(vs ...driver.Valuer) Condition {
+	return OrderedField[time.Time](f).InValuers(vs...)
+}
+func (f NullTimeField) InValuers(vs ...driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).InValuers(vs...)
+}
+
+func (f TimeField) NotInValuers(vs ...driver.Valuer) Condition {
+	return OrderedField[time.Time](f).NotInValuers(vs...)
+}
+func (f NullTimeField) NotInValuers(vs ...driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).NotInValuers(vs...)
+}
+
+func (f TimeField) Between(lo, hi time.Time) Condition {
+	return OrderedField[time.Time](f).Between(lo, hi)
+}
+func (f NullTimeField) Between(lo, hi *time.Time) Condition {
+	return NullOrderedField[time.Time](f).Between(lo, hi)
+}
+
+func (f TimeField) NotBetween(lo, hi time.Time) Condition {
+	return OrderedField[time.Time](f).NotBetween(lo, hi)
+}
+func (f NullTimeField) NotBetween(lo, hi *time.Time) Condition {
+	return NullOrderedField[time.Time](f).NotBetween(lo, hi)
+}
+
+func (f TimeField) InRange(lo, hi time.Time) Condition {
+	return OrderedField[time.Time](f).InRange(lo, hi)
+}
+func (f NullTimeField) InRange(lo, hi *time.Time) Condition {
+	return NullOrderedField[time.Time](f).InRange(lo, hi)
+}
+
+func (f TimeField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return OrderedField[time.Time](f).BetweenValuers(lo, hi)
+}
+func (f NullTimeField) BetweenValuers(lo, hi driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).BetweenValuers(lo, hi)
+}
+
+func (f TimeField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return OrderedField[time.Time](f).NotBetweenValuers(lo, hi)
+}
+func (f NullTimeField) NotBetweenValuers(lo, hi driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).NotBetweenValuers(lo, hi)
+}
+
+func (f TimeField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return OrderedField[time.Time](f).InRangeValuers(lo, hi)
+}
+func (f NullTimeField) InRangeValuers(lo, hi driver.Valuer) Condition {
+	return NullOrderedField[time.Time](f).InRangeValuers(lo, hi)
+}
+
+func (f TimeField) InQuery(sub Subquery) Condition {
+	return OrderedField[time.Time](f).InQuery(sub)
+}
+func (f NullTimeField) InQuery(sub Subquery) Condition {
+	return NullOrderedField[time.Time](f).InQuery(sub)
+}
+
+func (f TimeField) NotInQuery(sub Subquery) Condition {
+	return OrderedField[time.Time](f).NotInQuery(sub)
+}
+func (f NullTimeField) NotInQuery(sub Subquery) Condition {
+	return NullOrderedField[time.Time](f).NotInQuery(sub)
+}
+
+func (f TimeField) CompareTo(op Operand, q Quantified) Condition {
+	return OrderedField[time.Time](f).CompareTo(op, q)
+}
+func (f NullTimeField) CompareTo(op Operand, q Quantified) Condition {
+	return NullOrderedField[time.Time](f).CompareTo(op, q)
+}
+
+func (f TimeField) Before(t time.Time) Condition {
+	return Cond(string(f), OpLess, t)
+}
+func (f NullTimeField) Before(t time.Time) Condition {
+	return Cond(string(f), OpLess, t)
+}
+
+func (f TimeField) After(t time.Time) Condition {
+	return Cond(string(f), OpGreater, t)
+}
+func (f NullTimeField) After(t time.Time) Condition {
+	return Cond(string(f), OpGreater, t)
+}
+
+func (f TimeField) WithinLast(d time.Duration) Condition {
+	return CondWithinLast(string(f), d)
+}
+func (f NullTimeField) WithinLast(d time.Duration) Condition {
+	return CondWithinLast(string(f), d)
+}
+
+func (f TimeField) OlderThan(d time.Duration) Condition {
+	return CondOlderThan(string(f), d)
+}
+func (f NullTimeField) OlderThan(d time.Duration) Condition {
+	return CondOlderThan(string(f), d)
+}
+
+func (f TimeField) OnDate(date time.Time, loc *time.Location) Condition {
+	return CondOnDate(string(f), date, loc)
+}
+func (f NullTimeField) OnDate(date time.Time, loc *time.Location) Condition {
+	return CondOnDate(string(f), date, loc)
+}
+
+func (f TimeField) EqualsField(other Column[time.Time]) Condition {
+	return OrderedField[time.Time](f).EqualsField(other)
+}
+func (f NullTimeField) EqualsField(other Column[time.Time]) Condition {
+	return NullOrderedField[time.Time](f).EqualsField(other)
+}
+
+func (f TimeField) NotEqualsField(other Column[time.Time]) Condition {
+	return OrderedField[time.Time](f).NotEqualsField(other)
+}
+func (f NullTimeField) NotEqualsField(other Column[time.Time]) Condition {
+	return NullOrderedField[time.Time](f).NotEqualsField(other)
+}
+
+func (f TimeField) GreaterThanField(other Column[time.Time]) Condition {
+	return OrderedField[time.Time](f).GreaterThanField(other)
+}
+func (f NullTimeField) GreaterThanField(other Column[time.Time]) Condition {
+	return NullOrderedField[time.Time](f).GreaterThanField(other)
+}
+
+func (f TimeField) GreaterEqualField(other Column[time.Time]) Condition {
+	return OrderedField[time.Time](f).GreaterEqualField(other)
+}
+func (f NullTimeField) GreaterEqualField(other Column[time.Time]) Condition {
+	return NullOrderedField[time.Time](f).GreaterEqualField(other)
+}
+
+func (f TimeField) LessThanField(other Column[time.Time]) Condition {
+	return OrderedField[time.Time](f).LessThanField(other)
+}
+func (f NullTimeField) LessThanField(other Column[time.Time]) Condition {
+	return NullOrderedField[time.Time](f).LessThanField(other)
+}
+
+func (f TimeField) LessOrEqualField(other Column[time.Time]) Condition {
+	return OrderedField[time.Time](f).LessOrEqualField(other)
+}
+func (f NullTimeField) LessOrEqualField(other Column[time.Time]) Condition {
+	return NullOrderedField[time.Time](f).LessOrEqualField(other)
+}
+
+func (f NullTimeField) IsDistinctFromField(other Column[time.Time]) Condition {
+	return NullOrderedField[time.Time](f).IsDistinctFromField(other)
+}
+func (f NullTimeField) IsNotDistinctFromField(other Column[time.Time]) Condition {
+	return NullOrderedField[time.Time](f).IsNotDistinctFromField(other)
+}
+
+func (f TimeField) Ref() ColumnRef {
+	return OrderedField[time.Time](f).Ref()
+}
+func (f NullTimeField) Ref() ColumnRef {
+	return NullOrderedField[time.Time](f).Ref()
+}
+
+func (f TimeField) WithAlias(alias string) TimeField {
+	return TimeField(OrderedField[time.Time](f).WithAlias(alias))
+}
+func (f NullTimeField) WithAlias(alias string) NullTimeField {
+	return NullTimeField(NullOrderedField[time.Time](f).WithAlias(alias))
+}
+
+func (f TimeField) buildColumn(b *builder, _ *time.Time) {
+	OrderedField[time.Time](f).buildColumn(b, nil)
+}
+func (f NullTimeField) buildColumn(b *builder, _ *time.Time) {
+	NullOrderedField[time.Time](f).buildColumn(b, nil)
+}
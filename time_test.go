@@ -0,0 +1,56 @@
+package qm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// setNow makes now return t until the test ends.
+func setNow(t *testing.T, at time.Time) {
+	t.Helper()
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = time.Now })
+}
+
+func TestCondOnDate(t *testing.T) {
+	plus5 := time.FixedZone("UTC+5", 5*3600)
+	minus8 := time.FixedZone("UTC-8", -8*3600)
+	tests := []struct {
+		name string
+		date time.Time
+		loc  *time.Location
+		from time.Time
+	}{
+		{"utc", time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC), nil, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"next day east", time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC), plus5, time.Date(2024, 1, 2, 0, 0, 0, 0, plus5)},
+		{"previous day west", time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC), minus8, time.Date(2023, 12, 31, 0, 0, 0, 0, minus8)},
+		{"same zone", time.Date(2024, 3, 10, 12, 0, 0, 0, plus5), plus5, time.Date(2024, 3, 10, 0, 0, 0, 0, plus5)},
+		{"other zone", time.Date(2024, 3, 10, 1, 0, 0, 0, plus5), time.UTC, time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := TimeField("at").OnDate(tt.date, tt.loc).ToSQL(GoPG)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sql != "at >= ? AND at < ?" {
+				t.Errorf("sql = %q", sql)
+			}
+			want := []interface{}{tt.from, tt.from.AddDate(0, 0, 1)}
+			if !reflect.DeepEqual(args, want) {
+				t.Errorf("args = %v, want %v", args, want)
+			}
+		})
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	setNow(t, at)
+	runConditionTests(t, []conditionTest{
+		{"within last", TimeField("at").WithinLast(time.Hour), "at BETWEEN ? AND ?", []interface{}{at.Add(-time.Hour), at}},
+		{"older than", NullTimeField("at").OlderThan(24 * time.Hour), "at < ?", []interface{}{at.Add(-24 * time.Hour)}},
+		{"before", TimeField("at").LessThan(at), "at < ?", []interface{}{at}},
+	})
+}
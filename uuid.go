@@ -0,0 +1,278 @@
+package qm
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// UUID is a universally unique identifier as stored in a uuid column.
+type UUID [16]byte
+
+// ParseUUID parses s in the canonical 8-4-4-4-12 form, with or without
+// hyphens, braces or a urn:uuid: prefix, in either case.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	h := strings.TrimPrefix(strings.ToLower(s), "urn:uuid:")
+	if strings.HasPrefix(h, "{") && strings.HasSuffix(h, "}") {
+		h = h[1 : len(h)-1]
+	}
+	if len(h) == 36 {
+		if h[8] != '-' || h[13] != '-' || h[18] != '-' || h[23] != '-' {
+			return u, &UUIDError{Value: s}
+		}
+		h = h[:8] + h[9:13] + h[14:18] + h[19:23] + h[24:]
+	}
+	if len(h) != 32 {
+		return u, &UUIDError{Value: s}
+	}
+	if _, err := hex.Decode(u[:], []byte(h)); err != nil {
+		return u, &UUIDError{Value: s}
+	}
+	return u, nil
+}
+
+// MustParseUUID is like ParseUUID but panics if s is not a valid UUID.
+func MustParseUUID(s string) UUID {
+	u, err := ParseUUID(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// String returns u in the canonical lower case 8-4-4-4-12 form.
+func (u UUID) String() string {
+	h := hex.EncodeToString(u[:])
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// UUIDError is returned by ParseUUID for a string that is not a UUID.
+type UUIDError struct {
+	Value string
+}
+
+func (e *UUIDError) Error() string {
+	return fmt.Sprintf("qm: invalid UUID %q", e.Value)
+}
+
+// uuidArg binds u as a string cast to the uuid type.
+func uuidArg(u UUID) interface{} {
+	return castArg{value: u.String(), cast: "uuid"}
+}
+
+// nullUUIDArg is like uuidArg but keeps nil as it is, so that nullable fields
+// can compare against NULL.
+func nullUUIDArg(u *UUID) interface{} {
+	if u == nil {
+		return nil
+	}
+	return uuidArg(*u)
+}
+
+func uuidArgs(us []UUID) []interface{} {
+	args := make([]interface{}, len(us))
+	for i, u := range us {
+		args[i] = uuidArg(u)
+	}
+	return args
+}
+
+func nullUUIDArgs(us []*UUID) []interface{} {
+	args := make([]interface{}, len(us))
+	for i, u := range us {
+		args[i] = nullUUIDArg(u)
+	}
+	return args
+}
+
+// uuidValuer binds v, a driver.Valuer such as sql.NullString, cast to the
+// uuid type, its value being taken as is.
+func uuidValuer(v driver.Valuer) interface{} {
+	return castArg{value: v, cast: "uuid"}
+}
+
+func uuidValuers(vs []driver.Valuer) []interface{} {
+	args := make([]interface{}, len(vs))
+	for i, v := range vs {
+		args[i] = uuidValuer(v)
+	}
+	return args
+}
+
+// UUIDColumn is an SQL expression of the uuid type, implemented by UUIDField
+// and NullUUIDField.
+type UUIDColumn interface {
+	buildUUID(b *builder)
+}
+
+// UUIDField is a component that returns a WhereClause that contains a
+// comparison based on its field and a UUID. Values are given as UUID, strings
+// being parsed with ParseUUID first, and are bound in their canonical form
+// cast to uuid.
+type UUIDField string
+
+type NullUUIDField string
+
+func (f UUIDField) ToValue(v UUID) Condition {
+	return CondWithoutAlias(string(f), OpEquals, uuidArg(v))
+}
+
+func (f NullUUIDField) ToNullValue(v *UUID) Condition {
+	return CondWithoutAlias(string(f), OpEquals, nullUUIDArg(v))
+}
+
+func (f UUIDField) Equals(v UUID) Condition {
+	return Cond(string(f), OpEquals, uuidArg(v))
+}
+func (f NullUUIDField) Equals(v *UUID) Condition {
+	return CondNullable(string(f), OpEquals, nullUUIDArg(v))
+}
+
+func (f UUIDField) In(vs ...UUID) Condition {
+	return CondList(string(f), OpIN, uuidArgs(vs))
+}
+func (f NullUUIDField) In(vs ...*UUID) Condition {
+	return CondList(string(f), OpIN, nullUUIDArgs(vs))
+}
+
+func (f UUIDField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullUUIDField) IsNotNull() Condition {
+	return Cond(string(f), OpIsNotNull, nil)
+}
+func (f NullUUIDField) IsNull() Condition {
+	return Cond(string(f), OpIsNull, nil)
+}
+
+func (f UUIDField) NotEquals(v UUID) Condition {
+	return Cond(string(f), OpNotEquals, uuidArg(v))
+}
+func (f NullUUIDField) NotEquals(v *UUID) Condition {
+	return CondNullable(string(f), OpNotEquals, nullUUIDArg(v))
+}
+
+func (f NullUUIDField) IsDistinctFrom(v *UUID) Condition {
+	return Cond(string(f), OpIsDistinctFrom, nullUUIDArg(v))
+}
+func (f NullUUIDField) IsNotDistinctFrom(v *UUID) Condition {
+	return Cond(string(f), OpIsNotDistinctFrom, nullUUIDArg(v))
+}
+
+func (f UUIDField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, uuidValuer(v))
+}
+func (f NullUUIDField) EqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, uuidValuer(v))
+}
+
+func (f UUIDField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, uuidValuer(v))
+}
+func (f NullUUIDField) NotEqualsValuer(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, uuidValuer(v))
+}
+
+func (f UUIDField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, uuidValuer(v))
+}
+func (f NullUUIDField) CompareValuer(op Operand, v driver.Valuer) Condition {
+	return CondNullable(string(f), op, uuidValuer(v))
+}
+
+func (f NullUUIDField) EqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpEquals, uuidValuer(v))
+}
+func (f NullUUIDField) NotEqualsNull(v driver.Valuer) Condition {
+	return CondNullable(string(f), OpNotEquals, uuidValuer(v))
+}
+
+func (f UUIDField) NotIn(vs ...UUID) Condition {
+	return CondList(string(f), OpNotIN, uuidArgs(vs))
+}
+func (f NullUUIDField) NotIn(vs ...*UUID) Condition {
+	return CondList(string(f), OpNotIN, nullUUIDArgs(vs))
+}
+
+func (f UUIDField) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, uuidValuers(vs))
+}
+func (f NullUUIDField) InValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpIN, uuidValuers(vs))
+}
+
+func (f UUIDField) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, uuidValuers(vs))
+}
+func (f NullUUIDField) NotInValuers(vs ...driver.Valuer) Condition {
+	return CondList(string(f), OpNotIN, uuidValuers(vs))
+}
+
+func (f UUIDField) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+func (f NullUUIDField) InQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpIN, sub)
+}
+
+func (f UUIDField) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+func (f NullUUIDField) NotInQuery(sub Subquery) Condition {
+	return CondQuery(string(f), OpNotIN, sub)
+}
+
+func (f UUIDField) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+func (f NullUUIDField) CompareTo(op Operand, q Quantified) Condition {
+	return CondQuantified(string(f), op, q)
+}
+
+func (f UUIDField) EqualsField(other UUIDColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildUUID)
+}
+func (f NullUUIDField) EqualsField(other UUIDColumn) Condition {
+	return compareExpr(column(string(f)), OpEquals, other.buildUUID)
+}
+
+func (f UUIDField) NotEqualsField(other UUIDColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildUUID)
+}
+func (f NullUUIDField) NotEqualsField(other UUIDColumn) Condition {
+	return compareExpr(column(string(f)), OpNotEquals, other.buildUUID)
+}
+
+func (f NullUUIDField) IsDistinctFromField(other UUIDColumn) Condition {
+	return compareExpr(column(string(f)), OpIsDistinctFrom, other.buildUUID)
+}
+func (f NullUUIDField) IsNotDistinctFromField(other UUIDColumn) Condition {
+	return compareExpr(column(string(f)), OpIsNotDistinctFrom, other.buildUUID)
+}
+
+func (f UUIDField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+func (f NullUUIDField) Ref() ColumnRef {
+	return parseColumn(string(f))
+}
+
+func (f UUIDField) WithAlias(alias string) UUIDField {
+	return UUIDField(parseColumn(string(f)).WithTable(alias).String())
+}
+func (f NullUUIDField) WithAlias(alias string) NullUUIDField {
+	return NullUUIDField(parseColumn(string(f)).WithTable(alias).String())
+}
+
+func (f UUIDField) buildUUID(b *builder) {
+	b.writeColumn(string(f))
+}
+func (f NullUUIDField) buildUUID(b *builder) {
+	b.writeColumn(string(f))
+}
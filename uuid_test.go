@@ -0,0 +1,55 @@
+package qm
+
+import (
+	"errors"
+	"testing"
+)
+
+const testUUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+func TestParseUUID(t *testing.T) {
+	tests := []struct {
+		in    string
+		valid bool
+	}{
+		{testUUID, true},
+		{"6BA7B810-9DAD-11D1-80B4-00C04FD430C8", true},
+		{"6ba7b8109dad11d180b400c04fd430c8", true},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", true},
+		{"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", true},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c", false},
+		{"6ba7b810x9dad-11d1-80b4-00c04fd430c8", false},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430cg", false},
+		{"not-a-uuid", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		u, err := ParseUUID(tt.in)
+		var uerr *UUIDError
+		switch {
+		case tt.valid && err != nil:
+			t.Errorf("ParseUUID(%q): %v", tt.in, err)
+		case tt.valid && u.String() != testUUID:
+			t.Errorf("ParseUUID(%q) = %s", tt.in, u)
+		case !tt.valid && !errors.As(err, &uerr):
+			t.Errorf("ParseUUID(%q) = %s, %v, want a *UUIDError", tt.in, u, err)
+		}
+	}
+	if !panics(func() { MustParseUUID("x") }) {
+		t.Error("MustParseUUID did not panic")
+	}
+}
+
+func TestUUIDField(t *testing.T) {
+	id := UUIDField("id")
+	u := MustParseUUID(testUUID)
+	runConditionTests(t, []conditionTest{
+		{"equals", id.Equals(u), "id = ?::uuid", []interface{}{testUUID}},
+		{"not equals", id.NotEquals(u), "id != ?::uuid", []interface{}{testUUID}},
+		{"in", id.In(u, MustParseUUID("6BA7B810-9DAD-11D1-80B4-00C04FD430C8")), "id IN (?::uuid, ?::uuid)", []interface{}{testUUID, testUUID}},
+		{"null", NullUUIDField("id").Equals(nil), "id IS NULL", nil},
+		{"null in", NullUUIDField("id").In(&u, nil), "id IN (?::uuid) OR id IS NULL", []interface{}{testUUID}},
+		{"distinct", NullUUIDField("id").IsDistinctFrom(&u), "id IS DISTINCT FROM ?::uuid", []interface{}{testUUID}},
+		{"field", id.EqualsField(NullUUIDField("o.id")), "id = o.id", nil},
+	})
+}
@@ -0,0 +1,87 @@
+package qm
+
+import "database/sql/driver"
+
+// valuerOf returns the driver.Valuer bound by value, either directly or
+// through a castArg.
+func valuerOf(value interface{}) (driver.Valuer, bool) {
+	if a, ok := value.(castArg); ok {
+		value = a.value
+	}
+	v, ok := value.(driver.Valuer)
+	return v, ok
+}
+
+// resolveValuer returns the value of v, a nil pointer being NULL as it is
+// for database/sql.
+func resolveValuer(v driver.Valuer) (interface{}, error) {
+	if isNilValue(v) {
+		return nil, nil
+	}
+	return v.Value()
+}
+
+// valuerValue returns the value of v if it is a driver.Valuer and v itself
+// otherwise, for converters of values that can be given as custom types. A
+// Valuer whose value is nil, such as an invalid sql.NullString, is reported
+// as null.
+func valuerValue(v interface{}) (value interface{}, null bool, err error) {
+	valuer, ok := v.(driver.Valuer)
+	if !ok || isNilValue(valuer) {
+		return v, false, nil
+	}
+	if value, err = valuer.Value(); err != nil {
+		return nil, false, err
+	}
+	return value, value == nil, nil
+}
+
+// resolveArg returns nil in place of value if it is bound by a
+// driver.Valuer whose Value is nil, and value itself otherwise, so that it is
+// bound as it was given, the driver calling Value again, and cast arguments
+// convert the Valuer themselves. Errors returned by Value are reported to b,
+// value being kept rather than taken for NULL.
+func resolveArg(b *builder, value interface{}) interface{} {
+	v, ok := valuerOf(value)
+	if !ok {
+		return value
+	}
+	resolved, err := resolveValuer(v)
+	if err != nil {
+		b.setErr(err)
+		return value
+	}
+	if resolved == nil {
+		return nil
+	}
+	return value
+}
+
+// hasValuer reports whether any of values is bound by a driver.Valuer.
+func hasValuer(values ...interface{}) bool {
+	for _, value := range values {
+		if _, ok := valuerOf(value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// deferValuers returns the condition built by build once the Valuers it
+// binds are resolved, when the condition is rendered. The condition it
+// builds is taken to be compound as it is not known until then.
+func deferValuers(build func(b *builder) Condition) Condition {
+	return Condition{compound: true, build: func(b *builder) {
+		build(b).build(b)
+	}}
+}
+
+// condValuer is CondNullable for a value bound by a driver.Valuer, such as
+// sql.NullInt64 or a custom domain type. Whether it is NULL is only known
+// once its Value method is called, which is done when the condition is
+// rendered; errors it returns are reported by the condition's Err.
+func condValuer(col string, op Operand, value interface{}, v driver.Valuer) Condition {
+	return Condition{build: func(b *builder) {
+		condNullable(col, op, resolveArg(b, value)).build(b)
+	}}
+}
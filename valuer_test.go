@@ -0,0 +1,104 @@
+package qm
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEqualsNull(t *testing.T) {
+	at := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	n := sql.NullInt64{Int64: 3, Valid: true}
+	s := sql.NullString{String: "x", Valid: true}
+	runConditionTests(t, []conditionTest{
+		{"int64", NullInt64Field("n").EqualsNull(n), "n = ?", []interface{}{n}},
+		{"int64 invalid", NullInt64Field("n").EqualsNull(sql.NullInt64{}), "n IS NULL", nil},
+		{"int64 not equals invalid", NullInt64Field("n").NotEqualsNull(sql.NullInt64{}), "n IS NOT NULL", nil},
+		{"int32", NullInt32Field("n").EqualsNull(sql.NullInt32{}), "n IS NULL", nil},
+		{"rune", NullRuneField("n").EqualsNull(sql.NullInt32{}), "n IS NULL", nil},
+		{"float64", NullFloat64Field("n").NotEqualsNull(sql.NullFloat64{}), "n IS NOT NULL", nil},
+		{"other valuer", NullUint16Field("n").EqualsNull(sql.NullInt64{}), "n IS NULL", nil},
+		{"string", NullStringField("s").EqualsNull(s), "s = ?", []interface{}{s}},
+		{"bool", NullBoolField("b").EqualsNull(sql.NullBool{}), "b IS NULL", nil},
+		{"time", NullTimeField("t").EqualsNull(sql.NullTime{Time: at, Valid: true}), "t = ?", []interface{}{sql.NullTime{Time: at, Valid: true}}},
+		{"date", NullDateField("d").EqualsNull(sql.NullTime{}), "d IS NULL", nil},
+		{"complex", NullComplex64Field("c").EqualsNull(sql.NullString{}), "c IS NULL", nil},
+	})
+}
+
+func TestListValuers(t *testing.T) {
+	one := sql.NullInt64{Int64: 1, Valid: true}
+	two := sql.NullInt64{Int64: 2, Valid: true}
+	null := sql.NullInt64{}
+	n := Int64Field("n")
+	runConditionTests(t, []conditionTest{
+		{"in", n.InValuers(one, two), "n IN (?, ?)", []interface{}{one, two}},
+		{"in null", NullInt64Field("n").InValuers(one, null), "n IN (?) OR n IS NULL", []interface{}{one}},
+		{"in only null", n.InValuers(null), "n IS NULL", nil},
+		{"in nil pointer", n.InValuers((*sql.NullInt64)(nil)), "n IS NULL", nil},
+		{"not in null", n.NotInValuers(one, null), "n NOT IN (?) AND n IS NOT NULL", []interface{}{one}},
+		{"in empty", n.InValuers(), "1 = 0", nil},
+		{"in nested", Or(n.InValuers(one, null), n.Equals(3)), "(n IN (?) OR n IS NULL) OR n = ?", []interface{}{one, int64(3)}},
+		{"between", n.BetweenValuers(one, two), "n BETWEEN ? AND ?", []interface{}{one, two}},
+		{"between null low", n.BetweenValuers(null, two), "n <= ?", []interface{}{two}},
+		{"not between null high", NullInt64Field("n").NotBetweenValuers(one, null), "n < ?", []interface{}{one}},
+		{"between nulls", n.BetweenValuers(null, nil), "1 = 1", nil},
+		{"in range", n.InRangeValuers(one, null), "n >= ?", []interface{}{one}},
+		{"text", StringField("s").InValuers(sql.NullString{}), "s IS NULL", nil},
+		{"time", TimeField("t").InRangeValuers(sql.NullTime{}, nil), "1 = 1", nil},
+		{"bool", BoolField("b").NotInValuers(sql.NullBool{Bool: true, Valid: true}), "b NOT IN (?)", []interface{}{sql.NullBool{Bool: true, Valid: true}}},
+		{"complex", Complex128Field("c").InValuers(sql.NullString{}), "c IS NULL", nil},
+		{"date", DateField("d").BetweenValuers(Date{2024, 5, 1}, sql.NullTime{}), "d >= ?", []interface{}{Date{2024, 5, 1}}},
+	})
+}
+
+// TestCastListValuers checks that Valuers whose Value is nil are not bound
+// as NULL arguments by the fields casting their values.
+func TestCastListValuers(t *testing.T) {
+	id := sql.NullString{String: testUUID, Valid: true}
+	half := sql.NullString{String: "0.5", Valid: true}
+	runConditionTests(t, []conditionTest{
+		{"uuid in", NullUUIDField("id").InValuers(sql.NullString{}, id), "id IN (?::uuid) OR id IS NULL", []interface{}{id}},
+		{"uuid equals", UUIDField("id").EqualsValuer(id), "id = ?::uuid", []interface{}{id}},
+		{"decimal between", DecimalField("p").BetweenValuers(sql.NullString{}, half), "p <= ?::numeric", []interface{}{half}},
+		{"decimal range", DecimalField("p").InRangeValuers(half, sql.NullString{}), "p >= ?::numeric", []interface{}{half}},
+	})
+}
+
+func TestFailingValuer(t *testing.T) {
+	n := Int64Field("n")
+	tests := []struct {
+		name string
+		c    Condition
+	}{
+		{"equals", n.EqualsValuer(failingValuer{})},
+		{"in", n.InValuers(failingValuer{})},
+		{"between", n.BetweenValuers(failingValuer{}, sql.NullInt64{Int64: 1, Valid: true})},
+		{"in range", n.InRangeValuers(nil, failingValuer{})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, args, err := tt.c.ToSQL(GoPG)
+			if err == nil || err.Error() != "boom */ DROP" {
+				t.Fatalf("err = %v, want the error of Value", err)
+			}
+			// The Valuer is bound as it is rather than taken for NULL.
+			found := false
+			for _, arg := range args {
+				if reflect.DeepEqual(arg, failingValuer{}) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("args = %#v, want the failing Valuer", args)
+			}
+			if !panics(func() { tt.c.SQL() }) {
+				t.Error("SQL did not panic")
+			}
+		})
+	}
+	if err := n.InValuers(sql.NullInt64{}).Err(); err != nil {
+		t.Errorf("Err() = %v on a valid Valuer", err)
+	}
+}